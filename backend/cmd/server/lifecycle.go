@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"meli-backend/internal/buildinfo"
+	"meli-backend/internal/config"
+	"meli-backend/internal/metrics"
+	"meli-backend/internal/repositories"
+	"meli-backend/internal/tracing"
+	"net"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// dbBackoff is the delay between database connection attempts at startup. It
+// doubles after every failure up to max.
+var dbBackoff = backoff{initial: 500 * time.Millisecond, max: 10 * time.Second}
+
+type backoff struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+// run starts the server and blocks until SIGINT or SIGTERM, then drains the
+// in-flight requests and closes the database last. Errors say whether they
+// happened while starting or stopping.
+func run(ctx context.Context, cfg config.Config, logger *slog.Logger) (err error) {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		// a second signal while draining kills the process right away
+		<-ctx.Done()
+		stop()
+	}()
+
+	dbWrapper, err := connectDatabase(ctx, cfg, logger)
+	if err != nil {
+		return fmt.Errorf("startup failed: %w", err)
+	}
+	defer func() {
+		if closeErr := dbWrapper.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("shutdown failed: closing database: %w", closeErr))
+			return
+		}
+		logger.Info("database closed")
+	}()
+
+	build := buildinfo.Get()
+	shutdownTracing, err := tracing.Setup(ctx, newTracingOptions(cfg, build))
+	if err != nil {
+		return fmt.Errorf("startup failed: %w", err)
+	}
+	// deferred after closing the database, so it runs before: spans of the
+	// last requests are flushed while everything is still up
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTPShutdownTimeout)
+		defer cancel()
+		if flushErr := shutdownTracing(flushCtx); flushErr != nil {
+			err = errors.Join(err, fmt.Errorf("shutdown failed: flushing traces: %w", flushErr))
+		}
+	}()
+
+	var m *metrics.Metrics
+	if cfg.MetricsEnabled {
+		if m, err = newMetrics(cfg, dbWrapper); err != nil {
+			return fmt.Errorf("startup failed: %w", err)
+		}
+	}
+
+	limiter, closeLimiter := newRateLimiter(cfg)
+	defer func() {
+		if closeErr := closeLimiter(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("shutdown failed: closing rate limit store: %w", closeErr))
+		}
+	}()
+
+	server := newHTTPServer(cfg, newHandler(cfg, dbWrapper, logger, m, limiter))
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return fmt.Errorf("startup failed: %w", err)
+	}
+
+	logger.Info("server starting", "port", cfg.HTTPPort, "env", cfg.Env,
+		"version", build.Version, "commit", build.Commit, "build_time", build.BuildTime)
+	return serve(ctx, server, listener, cfg.HTTPShutdownTimeout, logger)
+}
+
+// connectDatabase opens the database, retrying with exponential backoff until
+// it is reachable or cfg.DBConnectTimeout elapses, as Postgres is often still
+// starting when the server does under docker-compose.
+func connectDatabase(ctx context.Context, cfg config.Config, logger *slog.Logger) (*repositories.DbWrapper, error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.DBConnectTimeout)
+	defer cancel()
+
+	var dbWrapper *repositories.DbWrapper
+	err := retry(ctx, dbBackoff, logger, func(ctx context.Context) error {
+		attemptCtx, cancel := context.WithTimeout(ctx, cfg.DBConnectAttemptTimeout)
+		defer cancel()
+
+		var err error
+		dbWrapper, err = repositories.NewDbWrapper(attemptCtx, newDbConfig(cfg))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("connecting to database %s:%s: %w", cfg.DBHost, cfg.DBPort, err)
+	}
+	return dbWrapper, nil
+}
+
+// retry calls op until it succeeds or ctx is done, waiting between attempts
+// as given by b. The returned error wraps the last failure.
+func retry(ctx context.Context, b backoff, logger *slog.Logger, op func(context.Context) error) error {
+	delay := b.initial
+	for attempt := 1; ; attempt++ {
+		err := op(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		logger.Warn("connection attempt failed", "attempt", attempt, "retry_in", delay.String(), "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		case <-timer.C:
+		}
+		delay = min(delay*2, b.max)
+	}
+}
+
+// serve runs server on listener until ctx is done, then stops accepting
+// connections and waits at most shutdownTimeout for in-flight requests.
+func serve(ctx context.Context, server *http.Server, listener net.Listener, shutdownTimeout time.Duration, logger *slog.Logger) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server stopped unexpectedly: %w", err)
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down, draining requests", "timeout", shutdownTimeout.String())
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		server.Close()
+		return fmt.Errorf("shutdown failed: draining requests: %w", err)
+	}
+	logger.Info("server stopped")
+	return nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"meli-backend/internal/config"
+	"meli-backend/internal/logging"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger() (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return logging.NewWithWriter(&buf, slog.LevelDebug), &buf
+}
+
+func TestRetry_SucceedsAfterFailures(t *testing.T) {
+	logger, buf := newTestLogger()
+	attempts := 0
+
+	err := retry(context.Background(), backoff{initial: time.Millisecond, max: 2 * time.Millisecond}, logger, func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Contains(t, buf.String(), `"attempt":2`)
+}
+
+func TestRetry_GivesUpWhenContextIsDone(t *testing.T) {
+	logger, _ := newTestLogger()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	refused := errors.New("connection refused")
+
+	err := retry(ctx, backoff{initial: 5 * time.Millisecond, max: 5 * time.Millisecond}, logger, func(context.Context) error {
+		return refused
+	})
+
+	assert.ErrorIs(t, err, refused)
+	assert.Contains(t, err.Error(), "giving up after")
+}
+
+func TestConnectDatabase_Timeout(t *testing.T) {
+	logger, _ := newTestLogger()
+	cfg := config.Config{
+		DBHost:                  "127.0.0.1",
+		DBPort:                  "1",
+		DBName:                  "app",
+		DBUser:                  "postgres",
+		DBConnectTimeout:        100 * time.Millisecond,
+		DBConnectAttemptTimeout: 50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	_, err := connectDatabase(context.Background(), cfg, logger)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connecting to database 127.0.0.1:1")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestServe_DrainsInFlightRequests(t *testing.T) {
+	logger, _ := newTestLogger()
+	started := make(chan struct{})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, server, listener, time.Second, logger)
+	}()
+
+	response := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			response <- 0
+			return
+		}
+		resp.Body.Close()
+		response <- resp.StatusCode
+	}()
+
+	<-started
+	cancel()
+
+	assert.NoError(t, <-done)
+	assert.Equal(t, http.StatusOK, <-response)
+}
+
+func TestServe_ShutdownTimeout(t *testing.T) {
+	logger, _ := newTestLogger()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, server, listener, 20*time.Millisecond, logger)
+	}()
+	go http.Get("http://" + listener.Addr().String())
+
+	<-started
+	cancel()
+
+	err = <-done
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "shutdown failed")
+}
+
+func TestServe_ListenerFailure(t *testing.T) {
+	logger, _ := newTestLogger()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener.Close()
+
+	err = serve(context.Background(), &http.Server{}, listener, time.Second, logger)
+
+	assert.Contains(t, err.Error(), "server stopped unexpectedly")
+}
@@ -1,67 +1,241 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
+	"meli-backend/internal/buildinfo"
+	"meli-backend/internal/cache"
+	"meli-backend/internal/config"
+	"meli-backend/internal/db/migrations"
+	"meli-backend/internal/http/cors"
+	"meli-backend/internal/http/ratelimit"
 	"meli-backend/internal/http/router"
+	"meli-backend/internal/logging"
+	"meli-backend/internal/metrics"
 	"meli-backend/internal/repositories"
 	"meli-backend/internal/service"
+	"meli-backend/internal/tracing"
 	"net/http"
 	"os"
 
-	"github.com/joho/godotenv"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found, using default values")
+	command, args := splitCommand(os.Args[1:])
+	var seedOpts seedOptions
+	if len(command) > 0 {
+		switch command[0] {
+		case "migrate":
+		case "seed":
+			var err error
+			seedOpts, args, err = parseSeedFlags(args)
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(0)
+			}
+			if err != nil {
+				log.Fatal(err)
+			}
+		default:
+			log.Fatalf("unknown command %q", command[0])
+		}
 	}
 
-	server := initializeServer()
-	defer server.Close()
-
-	port := getPort()
-	log.Printf("Server starting on port %s", port)
+	cfg, err := config.Load(args)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	server.Addr = ":" + port
+	logger, logOutput, err := logging.New(newLoggingOptions(cfg))
+	if err != nil {
+		log.Fatal("Failed to initialize logger: ", err)
+	}
+	defer logOutput.Close()
+	slog.SetDefault(logger)
 
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatal("Failed to start server:", err)
+	if len(command) > 0 {
+		if command[0] == "migrate" {
+			err = runMigrate(context.Background(), cfg, logger, command[1:], os.Stdout)
+		} else {
+			err = runSeed(context.Background(), cfg, logger, command[1:], seedOpts, os.Stdout)
+		}
+		if err != nil {
+			logger.Error(command[0]+" failed", "error", err)
+			logOutput.Close()
+			os.Exit(1)
+		}
+		return
 	}
-}
 
-func initializeServer() *http.Server {
-	// Initialize database connection
-	dbWrapper, err := repositories.NewDbWrapper(
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-		os.Getenv("DB_PORT"),
-	)
-	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
+	gin.SetMode(cfg.GinMode)
+
+	if err := run(context.Background(), cfg, logger); err != nil {
+		logger.Error("server exited with error", "error", err)
+		logOutput.Close()
+		os.Exit(1)
 	}
+}
 
+// newHandler wires repositories, services and the router on top of the
+// database. m is nil when metrics are disabled, and limiter when rate
+// limiting is.
+func newHandler(cfg config.Config, dbWrapper *repositories.DbWrapper, logger *slog.Logger, m *metrics.Metrics, limiter *ratelimit.Limiter) http.Handler {
 	// Initialize repositories
 	itemsRepository := repositories.New(dbWrapper)
+	searchRepository := repositories.NewSearchRepository(dbWrapper)
+	familiesRepository := repositories.NewFamiliesRepository(dbWrapper)
+	questionsRepository := repositories.NewQuestionsRepository(dbWrapper)
+	reviewsRepository := repositories.NewReviewsRepository(dbWrapper)
+	sellersRepository := repositories.NewSellersRepository(dbWrapper)
+	paymentsRepository := repositories.NewPaymentsRepository(dbWrapper)
+	exchangeRatesRepository := repositories.NewExchangeRatesRepository(dbWrapper)
+	healthRepository := repositories.NewHealthRepository(dbWrapper)
 
 	// Initialize services
-	itemService := service.NewItemService(itemsRepository)
+	currencyService := service.NewCurrencyService(exchangeRatesRepository)
+	// the item cache sits between the item service and its repository
+	var itemsSource service.ItemsRepositoryInterface = itemsRepository
+	var itemCache router.ItemCache
+	if cfg.ItemCacheEnabled {
+		items := cache.NewItems(itemsRepository, cfg.ItemCacheSize, cfg.ItemCacheTTL, newCacheObserver(m))
+		itemsSource, itemCache = items, items
+	}
+	itemService := service.NewItemService(itemsSource, currencyService)
+	searchService := service.NewSearchService(searchRepository)
+	familyService := service.NewFamilyService(familiesRepository)
+	questionService := service.NewQuestionService(questionsRepository, itemCache)
+	reviewService := service.NewReviewService(reviewsRepository, itemCache)
+	sellerService := service.NewSellerService(sellersRepository, itemsRepository)
+	installmentService := service.NewInstallmentService(paymentsRepository, currencyService)
+	healthService := service.NewHealthService(healthRepository, migrations.Latest())
 
 	// Initialize router with dependencies
 	routerInstance := router.NewRouter(router.Deps{
-		ItemService: itemService,
+		ItemService:        itemService,
+		SearchService:      searchService,
+		FamilyService:      familyService,
+		QuestionService:    questionService,
+		ReviewService:      reviewService,
+		SellerService:      sellerService,
+		InstallmentService: installmentService,
+		HealthService:      healthService,
+		ItemCache:          itemCache,
+		ItemCacheMaxAge:    cfg.ItemCacheMaxAge,
+		AdminToken:         cfg.AdminToken,
+		Logger:             logger,
+		Metrics:            m,
+		CORS:               newCORSPolicy(cfg),
+		TrustedProxies:     cfg.TrustedProxies,
+		RateLimiter:        limiter,
+		RateLimits:         newRateLimits(cfg),
 	})
 
-	return &http.Server{
-		Handler: routerInstance.Handler(),
+	return routerInstance.Handler()
+}
+
+// newCacheObserver returns m as a cache observer, or nil when metrics are
+// disabled: a nil *metrics.Metrics in the interface would not be nil.
+func newCacheObserver(m *metrics.Metrics) cache.Observer {
+	if m == nil {
+		return nil
+	}
+	return m
+}
+
+// newMetrics creates the metrics and instruments the database pool and the
+// repository operations.
+func newMetrics(cfg config.Config, dbWrapper *repositories.DbWrapper) (*metrics.Metrics, error) {
+	m := metrics.New()
+
+	sqlDB, err := dbWrapper.DB.DB()
+	if err != nil {
+		return nil, fmt.Errorf("registering database metrics: %w", err)
 	}
+	if err := m.RegisterDB(sqlDB, cfg.DBName); err != nil {
+		return nil, fmt.Errorf("registering database metrics: %w", err)
+	}
+	dbWrapper.Observer = m
+	return m, nil
 }
 
-func getPort() string {
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+func newCORSPolicy(cfg config.Config) cors.Policy {
+	return cors.Policy{
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           cfg.CORSMaxAge,
+	}
+}
+
+// newRateLimiter creates the limiter and the function closing its store. The
+// limiter is nil when rate limiting is disabled.
+func newRateLimiter(cfg config.Config) (*ratelimit.Limiter, func() error) {
+	if !cfg.RateLimitEnabled {
+		return nil, func() error { return nil }
+	}
+
+	key := ratelimit.ClientKey(cfg.RateLimitAPIKeys)
+	if cfg.RateLimitStore == "redis" {
+		client := redis.NewClient(&redis.Options{Addr: cfg.RateLimitRedisAddr})
+		return ratelimit.NewLimiter(ratelimit.NewRedisStore(client, "meli:ratelimit:"), key), client.Close
+	}
+	return ratelimit.NewLimiter(ratelimit.NewMemoryStore(), key), func() error { return nil }
+}
+
+func newRateLimits(cfg config.Config) router.RateLimits {
+	return router.RateLimits{
+		API:  ratelimit.Limit{Rate: cfg.RateLimitAPIRate, Burst: cfg.RateLimitAPIBurst},
+		Item: ratelimit.Limit{Rate: cfg.RateLimitItemRate, Burst: cfg.RateLimitItemBurst},
+	}
+}
+
+func newTracingOptions(cfg config.Config, build buildinfo.Info) tracing.Options {
+	return tracing.Options{
+		Exporter:       cfg.TracingExporter,
+		OTLPEndpoint:   cfg.TracingOTLPEndpoint,
+		SampleRatio:    cfg.TracingSampleRatio,
+		ServiceName:    "meli-backend",
+		ServiceVersion: build.Version,
+	}
+}
+
+func newLoggingOptions(cfg config.Config) logging.Options {
+	return logging.Options{
+		Level:      cfg.LogLevel,
+		File:       cfg.LogFile,
+		MaxSizeMB:  cfg.LogMaxSizeMB,
+		MaxBackups: cfg.LogMaxBackups,
+	}
+}
+
+func newDbConfig(cfg config.Config) repositories.DbConfig {
+	return repositories.DbConfig{
+		Host:            cfg.DBHost,
+		User:            cfg.DBUser,
+		Password:        cfg.DBPassword,
+		Name:            cfg.DBName,
+		Port:            cfg.DBPort,
+		SSLMode:         cfg.DBSSLMode,
+		MaxOpenConns:    cfg.DBMaxOpenConns,
+		MaxIdleConns:    cfg.DBMaxIdleConns,
+		ConnMaxLifetime: cfg.DBConnMaxLifetime,
+	}
+}
+
+func newHTTPServer(cfg config.Config, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              ":" + cfg.HTTPPort,
+		Handler:           handler,
+		ReadTimeout:       cfg.HTTPReadTimeout,
+		ReadHeaderTimeout: cfg.HTTPReadTimeout,
+		WriteTimeout:      cfg.HTTPWriteTimeout,
+		IdleTimeout:       cfg.HTTPIdleTimeout,
 	}
-	return port
 }
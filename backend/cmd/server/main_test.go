@@ -1,8 +1,18 @@
 package main
 
 import (
+	"meli-backend/internal/buildinfo"
+	"meli-backend/internal/config"
+	"meli-backend/internal/http/ratelimit"
+	"meli-backend/internal/http/router"
+	"meli-backend/internal/logging"
+	"meli-backend/internal/metrics"
+	"meli-backend/internal/repositories"
+	"meli-backend/internal/tracing"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -143,48 +153,112 @@ func TestMain_EnvironmentVariablePersistence(t *testing.T) {
 	}
 }
 
-func TestGetPort_WithEnvironmentVariable(t *testing.T) {
-	// Test getPort with environment variable set
-	originalPort := os.Getenv("PORT")
-	os.Setenv("PORT", "9090")
+func TestNewHTTPServer(t *testing.T) {
+	cfg := config.Config{
+		HTTPPort:         "9090",
+		HTTPReadTimeout:  5 * time.Second,
+		HTTPWriteTimeout: 10 * time.Second,
+		HTTPIdleTimeout:  time.Minute,
+	}
+	handler := http.NewServeMux()
 
-	result := getPort()
-	assert.Equal(t, "9090", result)
+	server := newHTTPServer(cfg, handler)
 
-	// Restore original value
-	if originalPort != "" {
-		os.Setenv("PORT", originalPort)
-	} else {
-		os.Unsetenv("PORT")
+	assert.Equal(t, ":9090", server.Addr)
+	assert.Equal(t, handler, server.Handler)
+	assert.Equal(t, 5*time.Second, server.ReadTimeout)
+	assert.Equal(t, 5*time.Second, server.ReadHeaderTimeout)
+	assert.Equal(t, 10*time.Second, server.WriteTimeout)
+	assert.Equal(t, time.Minute, server.IdleTimeout)
+}
+
+func TestNewTracingOptions(t *testing.T) {
+	cfg := config.Config{
+		TracingExporter:     "otlp",
+		TracingOTLPEndpoint: "collector:4318",
+		TracingSampleRatio:  0.25,
 	}
+
+	assert.Equal(t, tracing.Options{
+		Exporter:       "otlp",
+		OTLPEndpoint:   "collector:4318",
+		SampleRatio:    0.25,
+		ServiceName:    "meli-backend",
+		ServiceVersion: "1.2.0",
+	}, newTracingOptions(cfg, buildinfo.Info{Version: "1.2.0"}))
 }
 
-func TestGetPort_WithoutEnvironmentVariable(t *testing.T) {
-	// Test getPort without environment variable (should use default)
-	originalPort := os.Getenv("PORT")
-	os.Unsetenv("PORT")
+func TestNewRateLimiter(t *testing.T) {
+	limiter, closeLimiter := newRateLimiter(config.Config{RateLimitEnabled: false})
+	assert.Nil(t, limiter)
+	assert.NoError(t, closeLimiter())
 
-	result := getPort()
-	assert.Equal(t, "8080", result)
+	limiter, closeLimiter = newRateLimiter(config.Config{RateLimitEnabled: true, RateLimitStore: "memory"})
+	assert.NotNil(t, limiter)
+	assert.NoError(t, closeLimiter())
 
-	// Restore original value
-	if originalPort != "" {
-		os.Setenv("PORT", originalPort)
+	limiter, closeLimiter = newRateLimiter(config.Config{RateLimitEnabled: true, RateLimitStore: "redis", RateLimitRedisAddr: "localhost:6379"})
+	assert.NotNil(t, limiter)
+	assert.NoError(t, closeLimiter())
+}
+
+func TestNewRateLimits(t *testing.T) {
+	cfg := config.Config{
+		RateLimitAPIRate:   20,
+		RateLimitAPIBurst:  40,
+		RateLimitItemRate:  0.5,
+		RateLimitItemBurst: 5,
 	}
+
+	assert.Equal(t, router.RateLimits{
+		API:  ratelimit.Limit{Rate: 20, Burst: 40},
+		Item: ratelimit.Limit{Rate: 0.5, Burst: 5},
+	}, newRateLimits(cfg))
 }
 
-func TestGetPort_WithEmptyEnvironmentVariable(t *testing.T) {
-	// Test getPort with empty environment variable (should use default)
-	originalPort := os.Getenv("PORT")
-	os.Setenv("PORT", "")
+func TestNewCacheObserver(t *testing.T) {
+	assert.Nil(t, newCacheObserver(nil))
+	assert.NotNil(t, newCacheObserver(metrics.New()))
+}
 
-	result := getPort()
-	assert.Equal(t, "8080", result)
+func TestNewLoggingOptions(t *testing.T) {
+	cfg := config.Config{
+		LogLevel:      "debug",
+		LogFile:       "logs/app.log",
+		LogMaxSizeMB:  50,
+		LogMaxBackups: 3,
+	}
 
-	// Restore original value
-	if originalPort != "" {
-		os.Setenv("PORT", originalPort)
-	} else {
-		os.Unsetenv("PORT")
+	assert.Equal(t, logging.Options{
+		Level:      "debug",
+		File:       "logs/app.log",
+		MaxSizeMB:  50,
+		MaxBackups: 3,
+	}, newLoggingOptions(cfg))
+}
+
+func TestNewDbConfig(t *testing.T) {
+	cfg := config.Config{
+		DBHost:            "db",
+		DBUser:            "user",
+		DBPassword:        "secret",
+		DBName:            "meli_db",
+		DBPort:            "5433",
+		DBSSLMode:         "require",
+		DBMaxOpenConns:    20,
+		DBMaxIdleConns:    5,
+		DBConnMaxLifetime: time.Hour,
 	}
+
+	assert.Equal(t, repositories.DbConfig{
+		Host:            "db",
+		User:            "user",
+		Password:        "secret",
+		Name:            "meli_db",
+		Port:            "5433",
+		SSLMode:         "require",
+		MaxOpenConns:    20,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: time.Hour,
+	}, newDbConfig(cfg))
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"meli-backend/internal/config"
+	"meli-backend/internal/db/migrate"
+	"meli-backend/internal/db/migrations"
+	"os/signal"
+	"syscall"
+)
+
+const migrateUsage = "usage: meli-backend migrate up|down|status|redo [flags]"
+
+// splitCommand separates the subcommand words args starts with, such as
+// "migrate up", from the flags that follow. No words means serving.
+func splitCommand(args []string) ([]string, []string) {
+	for i, arg := range args {
+		if len(arg) > 0 && arg[0] == '-' {
+			return args[:i], args[i:]
+		}
+	}
+	return args, nil
+}
+
+// runMigrate runs the migrate subcommand against the configured database,
+// writing what was done to out.
+func runMigrate(ctx context.Context, cfg config.Config, logger *slog.Logger, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+	action, ok := migrateActions[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown migrate command %q, %s", args[0], migrateUsage)
+	}
+
+	loaded, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	dbWrapper, err := connectDatabase(ctx, cfg, logger)
+	if err != nil {
+		return err
+	}
+	defer dbWrapper.Close()
+
+	sqlDB, err := dbWrapper.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	return action(ctx, migrate.New(sqlDB, loaded, logger), out)
+}
+
+var migrateActions = map[string]func(context.Context, *migrate.Migrator, io.Writer) error{
+	"up": func(ctx context.Context, m *migrate.Migrator, out io.Writer) error {
+		applied, err := m.Up(ctx)
+		for _, migration := range applied {
+			fmt.Fprintf(out, "applied %s\n", migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Fprintln(out, "database is up to date")
+		}
+		return err
+	},
+	"down": func(ctx context.Context, m *migrate.Migrator, out io.Writer) error {
+		migration, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "rolled back %s\n", migration.Name)
+		return nil
+	},
+	"redo": func(ctx context.Context, m *migrate.Migrator, out io.Writer) error {
+		migration, err := m.Redo(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "redid %s\n", migration.Name)
+		return nil
+	},
+	"status": func(ctx context.Context, m *migrate.Migrator, out io.Writer) error {
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		pending := 0
+		for _, status := range statuses {
+			mark := " "
+			if status.Applied {
+				mark = "X"
+			} else {
+				pending++
+			}
+			fmt.Fprintf(out, "[%s] %s\n", mark, status.Name)
+		}
+		fmt.Fprintf(out, "\napplied: %d, pending: %d\n", len(statuses)-pending, pending)
+		return nil
+	},
+}
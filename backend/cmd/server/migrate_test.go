@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"meli-backend/internal/config"
+	"meli-backend/internal/db/migrate"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCommand(t *testing.T) {
+	command, args := splitCommand([]string{"migrate", "up", "--db-host", "db"})
+	assert.Equal(t, []string{"migrate", "up"}, command)
+	assert.Equal(t, []string{"--db-host", "db"}, args)
+
+	command, args = splitCommand([]string{"-http-port", "9090"})
+	assert.Empty(t, command)
+	assert.Equal(t, []string{"-http-port", "9090"}, args)
+
+	command, args = splitCommand(nil)
+	assert.Empty(t, command)
+	assert.Empty(t, args)
+}
+
+func TestRunMigrate_InvalidCommand(t *testing.T) {
+	logger, _ := newTestLogger()
+
+	err := runMigrate(context.Background(), config.Config{}, logger, nil, &bytes.Buffer{})
+	assert.EqualError(t, err, migrateUsage)
+
+	err = runMigrate(context.Background(), config.Config{}, logger, []string{"sideways"}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, `unknown migrate command "sideways"`)
+}
+
+func TestMigrateStatus_Output(t *testing.T) {
+	logger, _ := newTestLogger()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec("pg_advisory_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT version FROM schema_migrations").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow("001"))
+	mock.ExpectExec("pg_advisory_unlock").WillReturnResult(sqlmock.NewResult(0, 1))
+	m := migrate.New(db, []migrate.Migration{
+		{Version: "001", Name: "001_initial_setup.sql"},
+		{Version: "002", Name: "002_items_listing.sql"},
+	}, logger)
+
+	var out bytes.Buffer
+	require.NoError(t, migrateActions["status"](context.Background(), m, &out))
+
+	assert.Equal(t, "[X] 001_initial_setup.sql\n[ ] 002_items_listing.sql\n\napplied: 1, pending: 1\n", out.String())
+}
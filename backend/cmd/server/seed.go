@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"meli-backend/internal/config"
+	"meli-backend/internal/db/seed"
+	"meli-backend/internal/db/seeds"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+const seedUsage = "usage: meli-backend seed [--synthetic N [--synthetic-seed S]] [flags]"
+
+type seedOptions struct {
+	// synthetic is the number of items of the generated catalog, 0 to load
+	// the sample data instead
+	synthetic  int
+	randomSeed uint64
+}
+
+// parseSeedFlags parses the flags of the seed subcommand out of args and
+// returns the others, which are configuration flags.
+func parseSeedFlags(args []string) (seedOptions, []string, error) {
+	var opts seedOptions
+	fs := flag.NewFlagSet("meli-backend seed", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.IntVar(&opts.synthetic, "synthetic", 0, "generate a synthetic catalog of `N` items instead of loading the sample data")
+	fs.Uint64Var(&opts.randomSeed, "synthetic-seed", 1, "random seed of the synthetic catalog; the same seed gives the same catalog")
+
+	own, rest := extractFlags(fs, args)
+	if err := fs.Parse(own); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stderr)
+			fs.PrintDefaults()
+		}
+		return seedOptions{}, nil, err
+	}
+	if opts.synthetic < 0 {
+		return seedOptions{}, nil, errors.New("--synthetic must not be negative")
+	}
+	return opts, rest, nil
+}
+
+// extractFlags separates the flags defined in fs, with their values, from
+// the other arguments, so a subcommand can take its own flags among the
+// configuration ones.
+func extractFlags(fs *flag.FlagSet, args []string) ([]string, []string) {
+	var own, rest []string
+	for i := 0; i < len(args); i++ {
+		name, hasValue := strings.CutPrefix(args[i], "-")
+		name = strings.TrimPrefix(name, "-")
+		name, _, inline := strings.Cut(name, "=")
+		if !hasValue || fs.Lookup(name) == nil {
+			rest = append(rest, args[i])
+			continue
+		}
+		own = append(own, args[i])
+		if !inline && i+1 < len(args) {
+			i++
+			own = append(own, args[i])
+		}
+	}
+	return own, rest
+}
+
+// runSeed loads the sample data, or a synthetic catalog, into the configured
+// database, writing how many rows were inserted per table to out.
+func runSeed(ctx context.Context, cfg config.Config, logger *slog.Logger, args []string, opts seedOptions, out io.Writer) error {
+	if len(args) != 0 {
+		return errors.New(seedUsage)
+	}
+
+	var tables []seeds.Table
+	if opts.synthetic > 0 {
+		tables = seed.Synthetic(opts.synthetic, opts.randomSeed)
+	} else {
+		var err error
+		if tables, err = seeds.Load(seeds.FS); err != nil {
+			return err
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	dbWrapper, err := connectDatabase(ctx, cfg, logger)
+	if err != nil {
+		return err
+	}
+	defer dbWrapper.Close()
+
+	sqlDB, err := dbWrapper.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	results, err := seed.Apply(ctx, sqlDB, tables)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		fmt.Fprintf(out, "%-22s %6d rows, %6d inserted\n", result.Table, result.Rows, result.Inserted)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"meli-backend/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeedFlags(t *testing.T) {
+	opts, rest, err := parseSeedFlags([]string{"--db-host", "db", "--synthetic", "5000", "-synthetic-seed=7", "--log-level=debug"})
+
+	require.NoError(t, err)
+	assert.Equal(t, seedOptions{synthetic: 5000, randomSeed: 7}, opts)
+	assert.Equal(t, []string{"--db-host", "db", "--log-level=debug"}, rest)
+}
+
+func TestParseSeedFlags_Defaults(t *testing.T) {
+	opts, rest, err := parseSeedFlags(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, seedOptions{randomSeed: 1}, opts)
+	assert.Empty(t, rest)
+}
+
+func TestParseSeedFlags_Invalid(t *testing.T) {
+	_, _, err := parseSeedFlags([]string{"--synthetic", "many"})
+	assert.ErrorContains(t, err, `invalid value "many" for flag -synthetic`)
+
+	_, _, err = parseSeedFlags([]string{"--synthetic=-1"})
+	assert.EqualError(t, err, "--synthetic must not be negative")
+}
+
+func TestRunSeed_UnexpectedArguments(t *testing.T) {
+	logger, _ := newTestLogger()
+
+	err := runSeed(context.Background(), config.Config{}, logger, []string{"all"}, seedOptions{}, &bytes.Buffer{})
+
+	assert.EqualError(t, err, seedUsage)
+}
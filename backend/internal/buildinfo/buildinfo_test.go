@@ -0,0 +1,30 @@
+package buildinfo
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet_LinkerValues(t *testing.T) {
+	defer func(version, commit, buildTime string) {
+		Version, Commit, BuildTime = version, commit, buildTime
+	}(Version, Commit, BuildTime)
+	Version, Commit, BuildTime = "1.2.0", "abc123", "2025-01-01T00:00:00Z"
+
+	assert.Equal(t, Info{
+		Version:   "1.2.0",
+		Commit:    "abc123",
+		BuildTime: "2025-01-01T00:00:00Z",
+		GoVersion: runtime.Version(),
+	}, Get())
+}
+
+func TestGet_Defaults(t *testing.T) {
+	info := Get()
+
+	assert.Equal(t, "dev", info.Version)
+	assert.NotEmpty(t, info.Commit)
+	assert.NotEmpty(t, info.BuildTime)
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// itemsCacheName labels the item cache in metrics.
+const itemsCacheName = "items"
+
+type ItemsRepository interface {
+	GetEnriched(ctx context.Context, itemID string) (*domain.Item, error)
+	List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error)
+}
+
+// Observer is told about every lookup, e.g. *metrics.Metrics.
+type Observer interface {
+	ObserveCache(cache string, hit bool)
+}
+
+// Items is a read-through cache of enriched items in front of the items
+// repository. Concurrent misses for the same item share a single load.
+// Listings are not cached.
+type Items struct {
+	repository ItemsRepository
+	items      *LRU[string, *domain.Item]
+	loads      singleflight.Group
+	observer   Observer
+	// generation changes on every invalidation, so loads started before
+	// it do not cache what may be stale data.
+	generation atomic.Uint64
+}
+
+// NewItems caches up to size items for ttl. observer may be nil.
+func NewItems(repository ItemsRepository, size int, ttl time.Duration, observer Observer) *Items {
+	return &Items{
+		repository: repository,
+		items:      NewLRU[string, *domain.Item](size, ttl),
+		observer:   observer,
+	}
+}
+
+// GetEnriched returns a copy of the cached item, so callers may set its
+// fields, as the price conversion does, without changing the cache. Slices
+// are shared and must not be modified. Errors, not found included, are not
+// cached.
+func (c *Items) GetEnriched(ctx context.Context, itemID string) (*domain.Item, error) {
+	item, hit := c.items.Get(itemID)
+	c.observe(hit)
+	if hit {
+		return copyItem(item), nil
+	}
+
+	generation := c.generation.Load()
+	loaded := c.loads.DoChan(itemID, func() (interface{}, error) {
+		// the load is shared, it must not fail because the request that
+		// started it was cancelled
+		item, err := c.repository.GetEnriched(context.WithoutCancel(ctx), itemID)
+		if err != nil {
+			return nil, err
+		}
+		if c.generation.Load() == generation {
+			c.items.Add(itemID, item)
+		}
+		return item, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-loaded:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return copyItem(result.Val.(*domain.Item)), nil
+	}
+}
+
+func (c *Items) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	return c.repository.List(ctx, query)
+}
+
+// Invalidate drops an item and reports whether it was cached.
+func (c *Items) Invalidate(itemID string) bool {
+	c.generation.Add(1)
+	c.loads.Forget(itemID)
+	return c.items.Remove(itemID)
+}
+
+// InvalidateAll drops every item.
+func (c *Items) InvalidateAll() {
+	c.generation.Add(1)
+	c.items.Purge()
+}
+
+func (c *Items) observe(hit bool) {
+	if c.observer != nil {
+		c.observer.ObserveCache(itemsCacheName, hit)
+	}
+}
+
+func copyItem(item *domain.Item) *domain.Item {
+	itemCopy := *item
+	return &itemCopy
+}
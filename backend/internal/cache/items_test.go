@@ -0,0 +1,189 @@
+package cache
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemsRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemsRepository) GetEnriched(ctx context.Context, itemID string) (*domain.Item, error) {
+	args := m.Called(itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Item), args.Error(1)
+}
+
+func (m *MockItemsRepository) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemSummaryPage), args.Error(1)
+}
+
+type MockObserver struct {
+	mock.Mock
+}
+
+func (m *MockObserver) ObserveCache(cache string, hit bool) {
+	m.Called(cache, hit)
+}
+
+func TestItems_GetEnriched_ReadThrough(t *testing.T) {
+	repo := &MockItemsRepository{}
+	observer := &MockObserver{}
+	repo.On("GetEnriched", "MLA1").Return(&domain.Item{ID: "MLA1", Title: "Phone"}, nil).Once()
+	observer.On("ObserveCache", "items", false).Once()
+	observer.On("ObserveCache", "items", true).Once()
+	c := NewItems(repo, 10, time.Minute, observer)
+
+	item, err := c.GetEnriched(context.Background(), "MLA1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Phone", item.Title)
+
+	item, err = c.GetEnriched(context.Background(), "MLA1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Phone", item.Title)
+
+	repo.AssertExpectations(t)
+	observer.AssertExpectations(t)
+}
+
+func TestItems_GetEnriched_ReturnsCopies(t *testing.T) {
+	repo := &MockItemsRepository{}
+	repo.On("GetEnriched", "MLA1").Return(&domain.Item{ID: "MLA1", Title: "Phone"}, nil).Once()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	item, _ := c.GetEnriched(context.Background(), "MLA1")
+	item.Title = "changed"
+
+	item, _ = c.GetEnriched(context.Background(), "MLA1")
+	assert.Equal(t, "Phone", item.Title)
+}
+
+func TestItems_GetEnriched_ErrorsNotCached(t *testing.T) {
+	repo := &MockItemsRepository{}
+	repo.On("GetEnriched", "MLA1").Return(nil, domain.NewNotFound("item not found")).Twice()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	_, err := c.GetEnriched(context.Background(), "MLA1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = c.GetEnriched(context.Background(), "MLA1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	repo.AssertExpectations(t)
+}
+
+func TestItems_GetEnriched_CoalescesConcurrentMisses(t *testing.T) {
+	repo := &MockItemsRepository{}
+	release := make(chan time.Time)
+	repo.On("GetEnriched", "MLA1").
+		WaitUntil(release).
+		Return(&domain.Item{ID: "MLA1"}, nil).
+		Once()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			item, err := c.GetEnriched(context.Background(), "MLA1")
+			assert.NoError(t, err)
+			assert.Equal(t, "MLA1", item.ID)
+		}()
+	}
+	// let every goroutine join the load before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	repo.AssertNumberOfCalls(t, "GetEnriched", 1)
+}
+
+func TestItems_GetEnriched_CallerCancelled(t *testing.T) {
+	repo := &MockItemsRepository{}
+	release := make(chan time.Time)
+	repo.On("GetEnriched", "MLA1").
+		WaitUntil(release).
+		Return(&domain.Item{ID: "MLA1"}, nil).
+		Once()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.GetEnriched(ctx, "MLA1")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the load goes on and is cached for the next request
+	close(release)
+	assert.Eventually(t, func() bool { return c.items.Len() == 1 }, time.Second, time.Millisecond)
+	item, err := c.GetEnriched(context.Background(), "MLA1")
+	assert.NoError(t, err)
+	assert.Equal(t, "MLA1", item.ID)
+	repo.AssertNumberOfCalls(t, "GetEnriched", 1)
+}
+
+func TestItems_Invalidate(t *testing.T) {
+	repo := &MockItemsRepository{}
+	repo.On("GetEnriched", "MLA1").Return(&domain.Item{ID: "MLA1"}, nil)
+	repo.On("GetEnriched", "MLA2").Return(&domain.Item{ID: "MLA2"}, nil)
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	_, _ = c.GetEnriched(context.Background(), "MLA1")
+	_, _ = c.GetEnriched(context.Background(), "MLA2")
+
+	assert.True(t, c.Invalidate("MLA1"))
+	assert.False(t, c.Invalidate("MLA1"))
+	_, _ = c.GetEnriched(context.Background(), "MLA1")
+	repo.AssertNumberOfCalls(t, "GetEnriched", 3)
+
+	c.InvalidateAll()
+	_, _ = c.GetEnriched(context.Background(), "MLA1")
+	_, _ = c.GetEnriched(context.Background(), "MLA2")
+	repo.AssertNumberOfCalls(t, "GetEnriched", 5)
+}
+
+func TestItems_InvalidateDuringLoad(t *testing.T) {
+	repo := &MockItemsRepository{}
+	release := make(chan time.Time)
+	repo.On("GetEnriched", "MLA1").
+		WaitUntil(release).
+		Return(&domain.Item{ID: "MLA1", Title: "stale"}, nil).
+		Once()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = c.GetEnriched(context.Background(), "MLA1")
+	}()
+	time.Sleep(20 * time.Millisecond)
+	c.Invalidate("MLA1")
+	close(release)
+	<-done
+
+	assert.Equal(t, 0, c.items.Len())
+}
+
+func TestItems_List_NotCached(t *testing.T) {
+	repo := &MockItemsRepository{}
+	query := domain.ItemListQuery{Limit: 10}
+	repo.On("List", query).Return(&domain.ItemSummaryPage{}, nil).Twice()
+	c := NewItems(repo, 10, time.Minute, nil)
+
+	_, _ = c.List(context.Background(), query)
+	_, _ = c.List(context.Background(), query)
+
+	repo.AssertExpectations(t)
+}
@@ -1,44 +1,373 @@
 package config
 
 import (
-	"log"
-	"os"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
 )
 
 type Config struct {
-	HTTPPort   string
-	DBHost     string
-	Env        string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	DBPort     string
+	Env     string
+	GinMode string
+
+	HTTPPort            string
+	HTTPReadTimeout     time.Duration
+	HTTPWriteTimeout    time.Duration
+	HTTPIdleTimeout     time.Duration
+	HTTPShutdownTimeout time.Duration
+
+	DBHost            string
+	DBUser            string
+	DBPassword        string
+	DBName            string
+	DBPort            string
+	DBSSLMode         string
+	DBMaxOpenConns    int
+	DBMaxIdleConns    int
+	DBConnMaxLifetime time.Duration
+
+	DBConnectTimeout        time.Duration
+	DBConnectAttemptTimeout time.Duration
+
+	LogLevel      string
+	LogFile       string
+	LogMaxSizeMB  int
+	LogMaxBackups int
+
+	CORSAllowedOrigins   []string
+	CORSAllowCredentials bool
+	CORSMaxAge           time.Duration
+
+	TrustedProxies []string
+
+	RateLimitEnabled   bool
+	RateLimitStore     string
+	RateLimitRedisAddr string
+	RateLimitAPIRate   float64
+	RateLimitAPIBurst  int
+	RateLimitItemRate  float64
+	RateLimitItemBurst int
+	RateLimitAPIKeys   []string
+
+	ItemCacheEnabled bool
+	ItemCacheSize    int
+	ItemCacheTTL     time.Duration
+	ItemCacheMaxAge  time.Duration
+
+	AdminToken string
+
+	MetricsEnabled bool
+
+	TracingExporter     string
+	TracingOTLPEndpoint string
+	TracingSampleRatio  float64
+}
+
+// setting is a single configuration key. Key is the environment variable
+// name; config files use the same name (case-insensitive, nesting joined by
+// "_") and flags its kebab-case form, e.g. --http-port.
+type setting struct {
+	key     string
+	def     string
+	usage   string
+	aliases []string
+}
+
+var settings = []setting{
+	{key: "ENV", def: "development", usage: "deployment environment: development, test or production"},
+	{key: "GIN_MODE", def: "release", usage: "gin mode: debug, release or test"},
+	// PORT is still read so existing deployments keep working
+	{key: "HTTP_PORT", def: "8080", usage: "HTTP listen port", aliases: []string{"PORT"}},
+	{key: "HTTP_READ_TIMEOUT", def: "15s", usage: "maximum duration to read a request"},
+	{key: "HTTP_WRITE_TIMEOUT", def: "15s", usage: "maximum duration to write a response"},
+	{key: "HTTP_IDLE_TIMEOUT", def: "60s", usage: "keep-alive idle timeout"},
+	{key: "HTTP_SHUTDOWN_TIMEOUT", def: "10s", usage: "time given to in-flight requests on shutdown"},
+	{key: "DB_HOST", def: "localhost", usage: "database host"},
+	{key: "DB_USER", def: "postgres", usage: "database user"},
+	{key: "DB_PASSWORD", def: "postgres", usage: "database password"},
+	{key: "DB_NAME", def: "app", usage: "database name"},
+	{key: "DB_PORT", def: "5432", usage: "database port"},
+	{key: "DB_SSL_MODE", def: "disable", usage: "postgres sslmode"},
+	{key: "DB_MAX_OPEN_CONNS", def: "100", usage: "maximum open database connections"},
+	{key: "DB_MAX_IDLE_CONNS", def: "10", usage: "maximum idle database connections"},
+	{key: "DB_CONN_MAX_LIFETIME", def: "30m", usage: "maximum lifetime of a database connection"},
+	{key: "DB_CONNECT_TIMEOUT", def: "60s", usage: "time spent retrying the database connection at startup"},
+	{key: "DB_CONNECT_ATTEMPT_TIMEOUT", def: "5s", usage: "timeout of a single database connection attempt"},
+	{key: "LOG_LEVEL", def: "info", usage: "log level: debug, info, warn or error"},
+	{key: "LOG_FILE", def: "", usage: "log file path, stdout when empty"},
+	{key: "LOG_MAX_SIZE_MB", def: "100", usage: "size in MB at which LOG_FILE is rotated"},
+	{key: "LOG_MAX_BACKUPS", def: "5", usage: "number of rotated log files kept"},
+	// no other origin may call the API unless listed
+	{key: "CORS_ALLOWED_ORIGINS", def: "", usage: "comma separated list of allowed origins besides the API's own, e.g. https://*.example.com; none when empty"},
+	{key: "CORS_ALLOW_CREDENTIALS", def: "false", usage: "let browsers send cookies and Authorization cross-origin"},
+	{key: "CORS_MAX_AGE", def: "10m", usage: "how long browsers may cache a preflight response"},
+	{key: "TRUSTED_PROXIES", def: "", usage: "comma separated IPs or CIDRs of proxies whose X-Forwarded-For is trusted"},
+	{key: "RATE_LIMIT_ENABLED", def: "true", usage: "limit the requests of every client to the API"},
+	{key: "RATE_LIMIT_STORE", def: "memory", usage: "rate limit store: memory or redis"},
+	{key: "RATE_LIMIT_REDIS_ADDR", def: "localhost:6379", usage: "host:port of the Redis rate limit store"},
+	{key: "RATE_LIMIT_API_RATE", def: "20/s", usage: "requests a client may send to the API, e.g. 20/s or 600/m"},
+	{key: "RATE_LIMIT_API_BURST", def: "40", usage: "requests a client may send to the API at once"},
+	{key: "RATE_LIMIT_ITEM_RATE", def: "5/s", usage: "requests a client may send to the item detail endpoint"},
+	{key: "RATE_LIMIT_ITEM_BURST", def: "10", usage: "requests a client may send to the item detail endpoint at once"},
+	{key: "RATE_LIMIT_API_KEYS", def: "", usage: "comma separated API keys; clients sending one are limited by key instead of IP"},
+	{key: "ITEM_CACHE_ENABLED", def: "true", usage: "cache enriched items in memory"},
+	{key: "ITEM_CACHE_SIZE", def: "1000", usage: "maximum number of cached items"},
+	{key: "ITEM_CACHE_TTL", def: "1m", usage: "how long an item stays cached"},
+	{key: "ITEM_CACHE_MAX_AGE", def: "30s", usage: "how long clients may reuse an item detail (Cache-Control max-age)"},
+	{key: "ADMIN_TOKEN", def: "", usage: "bearer token of the admin endpoints and of answering questions, which are disabled when empty"},
+	{key: "METRICS_ENABLED", def: "true", usage: "expose Prometheus metrics on /metrics"},
+	{key: "TRACING_EXPORTER", def: "none", usage: "trace exporter: none, stdout or otlp"},
+	{key: "TRACING_OTLP_ENDPOINT", def: "localhost:4318", usage: "host:port of the OTLP/HTTP collector"},
+	{key: "TRACING_SAMPLE_RATIO", def: "1", usage: "share of new traces recorded, between 0 and 1"},
+}
+
+var (
+	envs      = []string{"development", "test", "production"}
+	ginModes  = []string{"debug", "release", "test"}
+	logLevels = []string{"debug", "info", "warn", "error"}
+	sslModes  = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+	traceExporters  = []string{"none", "stdout", "otlp"}
+	rateLimitStores = []string{"memory", "redis"}
+)
+
+// Load resolves every setting from, lowest to highest precedence: defaults,
+// the optional config file (--config or CONFIG_FILE, YAML or TOML), the .env
+// file (--env-file), environment variables and command-line flags. A setting
+// given an empty value is empty, whatever lower sources say, and settings
+// needing a value reject it. Every invalid value is reported at once.
+func Load(args []string) (Config, error) {
+	values, err := resolve(args)
+	if err != nil {
+		return Config{}, err
+	}
+	return parse(values)
 }
 
-func Load() Config {
-	_ = loadDotEnvIfExists()
+func parse(values map[string]string) (Config, error) {
+	p := &parser{values: values}
 
 	cfg := Config{
-		HTTPPort:   get("HTTP_PORT", "8080"),
-		DBHost:     get("DB_HOST", "localhost"),
-		DBUser:     get("DB_USER", "postgres"),
-		DBPassword: get("DB_PASSWORD", "postgres"),
-		DBName:     get("DB_NAME", "app"),
-		DBPort:     get("DB_PORT", "5432"),
+		Env:     p.oneOf("ENV", envs),
+		GinMode: p.oneOf("GIN_MODE", ginModes),
+
+		HTTPPort:            p.port("HTTP_PORT"),
+		HTTPReadTimeout:     p.duration("HTTP_READ_TIMEOUT"),
+		HTTPWriteTimeout:    p.duration("HTTP_WRITE_TIMEOUT"),
+		HTTPIdleTimeout:     p.duration("HTTP_IDLE_TIMEOUT"),
+		HTTPShutdownTimeout: p.duration("HTTP_SHUTDOWN_TIMEOUT"),
+
+		DBHost:            p.required("DB_HOST"),
+		DBUser:            p.required("DB_USER"),
+		DBPassword:        values["DB_PASSWORD"],
+		DBName:            p.required("DB_NAME"),
+		DBPort:            p.port("DB_PORT"),
+		DBSSLMode:         p.oneOf("DB_SSL_MODE", sslModes),
+		DBMaxOpenConns:    p.positiveInt("DB_MAX_OPEN_CONNS"),
+		DBMaxIdleConns:    p.positiveInt("DB_MAX_IDLE_CONNS"),
+		DBConnMaxLifetime: p.duration("DB_CONN_MAX_LIFETIME"),
+
+		DBConnectTimeout:        p.duration("DB_CONNECT_TIMEOUT"),
+		DBConnectAttemptTimeout: p.duration("DB_CONNECT_ATTEMPT_TIMEOUT"),
+
+		LogLevel:      p.oneOf("LOG_LEVEL", logLevels),
+		LogFile:       values["LOG_FILE"],
+		LogMaxSizeMB:  p.positiveInt("LOG_MAX_SIZE_MB"),
+		LogMaxBackups: p.nonNegativeInt("LOG_MAX_BACKUPS"),
+
+		CORSAllowedOrigins:   p.origins("CORS_ALLOWED_ORIGINS"),
+		CORSAllowCredentials: p.boolean("CORS_ALLOW_CREDENTIALS"),
+		CORSMaxAge:           p.duration("CORS_MAX_AGE"),
+
+		TrustedProxies: p.proxies("TRUSTED_PROXIES"),
+
+		RateLimitEnabled:   p.boolean("RATE_LIMIT_ENABLED"),
+		RateLimitStore:     p.oneOf("RATE_LIMIT_STORE", rateLimitStores),
+		RateLimitRedisAddr: values["RATE_LIMIT_REDIS_ADDR"],
+		RateLimitAPIRate:   p.rate("RATE_LIMIT_API_RATE"),
+		RateLimitAPIBurst:  p.positiveInt("RATE_LIMIT_API_BURST"),
+		RateLimitItemRate:  p.rate("RATE_LIMIT_ITEM_RATE"),
+		RateLimitItemBurst: p.positiveInt("RATE_LIMIT_ITEM_BURST"),
+		RateLimitAPIKeys:   p.list("RATE_LIMIT_API_KEYS"),
+
+		ItemCacheEnabled: p.boolean("ITEM_CACHE_ENABLED"),
+		ItemCacheSize:    p.positiveInt("ITEM_CACHE_SIZE"),
+		ItemCacheTTL:     p.duration("ITEM_CACHE_TTL"),
+		ItemCacheMaxAge:  p.duration("ITEM_CACHE_MAX_AGE"),
+
+		AdminToken: values["ADMIN_TOKEN"],
+
+		MetricsEnabled: p.boolean("METRICS_ENABLED"),
+
+		TracingExporter:     p.oneOf("TRACING_EXPORTER", traceExporters),
+		TracingOTLPEndpoint: values["TRACING_OTLP_ENDPOINT"],
+		TracingSampleRatio:  p.ratio("TRACING_SAMPLE_RATIO"),
+	}
+
+	if cfg.RateLimitEnabled && cfg.RateLimitStore == "redis" {
+		p.required("RATE_LIMIT_REDIS_ADDR")
+	}
+	if cfg.TracingExporter == "otlp" {
+		p.required("TRACING_OTLP_ENDPOINT")
+	}
+	if cfg.DBMaxIdleConns > cfg.DBMaxOpenConns && cfg.DBMaxOpenConns > 0 {
+		p.fail("DB_MAX_IDLE_CONNS", "must not be greater than DB_MAX_OPEN_CONNS (%d), got %d", cfg.DBMaxOpenConns, cfg.DBMaxIdleConns)
+	}
+	if cfg.CORSAllowCredentials && lo.Contains(cfg.CORSAllowedOrigins, "*") {
+		p.fail("CORS_ALLOW_CREDENTIALS", "cannot be enabled when CORS_ALLOWED_ORIGINS contains *")
+	}
+
+	if len(p.errs) > 0 {
+		return Config{}, fmt.Errorf("invalid configuration:\n%w", errors.Join(p.errs...))
+	}
+	return cfg, nil
+}
+
+// parser converts raw values and collects every validation error.
+type parser struct {
+	values map[string]string
+	errs   []error
+}
+
+func (p *parser) fail(key, format string, args ...interface{}) {
+	p.errs = append(p.errs, fmt.Errorf("%s "+format, append([]interface{}{key}, args...)...))
+}
+
+func (p *parser) required(key string) string {
+	value := p.values[key]
+	if value == "" {
+		p.fail(key, "is required")
+	}
+	return value
+}
+
+func (p *parser) oneOf(key string, allowed []string) string {
+	value := strings.ToLower(p.values[key])
+	if !lo.Contains(allowed, value) {
+		p.fail(key, "must be one of %s, got %q", strings.Join(allowed, ", "), p.values[key])
+	}
+	return value
+}
+
+func (p *parser) port(key string) string {
+	value := p.values[key]
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		p.fail(key, "must be a port number between 1 and 65535, got %q", value)
+	}
+	return value
+}
+
+func (p *parser) positiveInt(key string) int {
+	value := p.values[key]
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		p.fail(key, "must be a positive integer, got %q", value)
+	}
+	return n
+}
+
+func (p *parser) nonNegativeInt(key string) int {
+	value := p.values[key]
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		p.fail(key, "must be zero or a positive integer, got %q", value)
+	}
+	return n
+}
+
+func (p *parser) boolean(key string) bool {
+	value := p.values[key]
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		p.fail(key, "must be true or false, got %q", value)
+	}
+	return b
+}
+
+func (p *parser) ratio(key string) float64 {
+	value := p.values[key]
+	r, err := strconv.ParseFloat(value, 64)
+	if err != nil || r < 0 || r > 1 {
+		p.fail(key, "must be a number between 0 and 1, got %q", value)
+	}
+	return r
+}
+
+func (p *parser) duration(key string) time.Duration {
+	value := p.values[key]
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		p.fail(key, "must be a positive duration such as 15s or 1m, got %q", value)
+	}
+	return d
+}
+
+// list splits a comma separated value, dropping empty entries.
+func (p *parser) list(key string) []string {
+	var items []string
+	for _, item := range strings.Split(p.values[key], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// rate parses a number of requests per unit of time, such as 20/s, 600/m or
+// 1000/h, into requests per second.
+func (p *parser) rate(key string) float64 {
+	value := p.values[key]
+	units := map[string]time.Duration{"s": time.Second, "m": time.Minute, "h": time.Hour}
+
+	count, unit, _ := strings.Cut(value, "/")
+	n, err := strconv.ParseFloat(count, 64)
+	per, ok := units[unit]
+	if err != nil || !ok || n <= 0 {
+		p.fail(key, "must be a rate such as 20/s, 600/m or 1000/h, got %q", value)
+		return 0
 	}
-	return cfg
+	return n / per.Seconds()
 }
 
-func get(key, def string) string {
-	if v := os.Getenv(key); v != "" {
-		return v
+// proxies parses a list of IPs or CIDRs.
+func (p *parser) proxies(key string) []string {
+	proxies := p.list(key)
+	for _, proxy := range proxies {
+		if _, err := netip.ParsePrefix(proxy); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(proxy); err != nil {
+			p.fail(key, "must contain IPs or CIDRs such as 10.0.0.0/8, got %q", proxy)
+		}
 	}
-	return def
+	return proxies
 }
 
-func loadDotEnvIfExists() error {
-	if _, err := os.Stat(".env"); err == nil {
-		log.Println("use godotenv to load .env") // TODO: usar godotenv
+// origins parses a list of origins. An origin may start its host with a
+// "*." label to allow every subdomain, e.g. https://*.example.com.
+func (p *parser) origins(key string) []string {
+	var origins []string
+	for _, origin := range strings.Split(p.values[key], ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		if origin != "*" {
+			u, err := url.Parse(origin)
+			if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") ||
+				strings.Contains(strings.TrimPrefix(u.Host, "*."), "*") {
+				p.fail(key, "must contain origins such as https://example.com, https://*.example.com or *, got %q", origin)
+				continue
+			}
+		}
+		origins = append(origins, strings.TrimSuffix(origin, "/"))
 	}
-	return nil
+	return origins
 }
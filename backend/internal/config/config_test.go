@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,7 +18,8 @@ func TestConfig_Load_WithDefaultValues(t *testing.T) {
 	os.Unsetenv("DB_NAME")
 	os.Unsetenv("DB_PORT")
 
-	cfg := Load()
+	cfg, err := Load(nil)
+	assert.NoError(t, err)
 
 	assert.Equal(t, "8080", cfg.HTTPPort)
 	assert.Equal(t, "localhost", cfg.DBHost)
@@ -35,7 +38,8 @@ func TestConfig_Load_WithEnvironmentVariables(t *testing.T) {
 	os.Setenv("DB_NAME", "test-db")
 	os.Setenv("DB_PORT", "5433")
 
-	cfg := Load()
+	cfg, err := Load(nil)
+	assert.NoError(t, err)
 
 	assert.Equal(t, "9090", cfg.HTTPPort)
 	assert.Equal(t, "test-db-host", cfg.DBHost)
@@ -59,7 +63,8 @@ func TestConfig_Load_WithMixedValues(t *testing.T) {
 	os.Setenv("DB_USER", "custom-user")
 	os.Setenv("DB_PASSWORD", "custom-password")
 
-	cfg := Load()
+	cfg, err := Load(nil)
+	assert.NoError(t, err)
 
 	assert.Equal(t, "7070", cfg.HTTPPort)
 	assert.Equal(t, "localhost", cfg.DBHost) // default
@@ -75,30 +80,21 @@ func TestConfig_Load_WithMixedValues(t *testing.T) {
 }
 
 func TestConfig_Load_WithEmptyEnvironmentVariables(t *testing.T) {
-	// Set empty environment variables
-	os.Setenv("HTTP_PORT", "")
-	os.Setenv("DB_HOST", "")
-	os.Setenv("DB_USER", "")
-	os.Setenv("DB_PASSWORD", "")
-	os.Setenv("DB_NAME", "")
-	os.Setenv("DB_PORT", "")
+	// empty values are not replaced by the defaults
+	t.Setenv("HTTP_PORT", "")
+	t.Setenv("DB_HOST", "")
+	t.Setenv("DB_USER", "")
+	t.Setenv("DB_PASSWORD", "")
+	t.Setenv("DB_NAME", "")
+	t.Setenv("DB_PORT", "")
 
-	cfg := Load()
+	_, err := Load(nil)
 
-	assert.Equal(t, "8080", cfg.HTTPPort)
-	assert.Equal(t, "localhost", cfg.DBHost)
-	assert.Equal(t, "postgres", cfg.DBUser)
-	assert.Equal(t, "postgres", cfg.DBPassword)
-	assert.Equal(t, "app", cfg.DBName)
-	assert.Equal(t, "5432", cfg.DBPort)
-
-	// Clean up
-	os.Unsetenv("HTTP_PORT")
-	os.Unsetenv("DB_HOST")
-	os.Unsetenv("DB_USER")
-	os.Unsetenv("DB_PASSWORD")
-	os.Unsetenv("DB_PORT")
-	os.Unsetenv("DB_NAME")
+	assert.Error(t, err)
+	for _, key := range []string{"HTTP_PORT", "DB_HOST", "DB_USER", "DB_NAME", "DB_PORT"} {
+		assert.ErrorContains(t, err, key)
+	}
+	assert.NotContains(t, err.Error(), "DB_PASSWORD")
 }
 
 func TestConfig_Load_WithSpecialCharacters(t *testing.T) {
@@ -107,7 +103,8 @@ func TestConfig_Load_WithSpecialCharacters(t *testing.T) {
 	os.Setenv("DB_NAME", "test-db_123")
 	os.Setenv("DB_HOST", "test-host.example.com")
 
-	cfg := Load()
+	cfg, err := Load(nil)
+	assert.NoError(t, err)
 
 	assert.Equal(t, "p@ssw0rd!@#$%", cfg.DBPassword)
 	assert.Equal(t, "test-db_123", cfg.DBName)
@@ -118,3 +115,264 @@ func TestConfig_Load_WithSpecialCharacters(t *testing.T) {
 	os.Unsetenv("DB_NAME")
 	os.Unsetenv("DB_HOST")
 }
+
+func TestConfig_Load_Defaults(t *testing.T) {
+	cfg, err := Load(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "development", cfg.Env)
+	assert.Equal(t, "release", cfg.GinMode)
+	assert.Equal(t, 15*time.Second, cfg.HTTPReadTimeout)
+	assert.Equal(t, 10*time.Second, cfg.HTTPShutdownTimeout)
+	assert.Equal(t, 100, cfg.DBMaxOpenConns)
+	assert.Equal(t, 10, cfg.DBMaxIdleConns)
+	assert.Equal(t, "disable", cfg.DBSSLMode)
+	assert.Equal(t, time.Minute, cfg.DBConnectTimeout)
+	assert.Equal(t, 5*time.Second, cfg.DBConnectAttemptTimeout)
+	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Equal(t, "", cfg.LogFile)
+	assert.Equal(t, 100, cfg.LogMaxSizeMB)
+	assert.Equal(t, 5, cfg.LogMaxBackups)
+	assert.Empty(t, cfg.CORSAllowedOrigins)
+	assert.False(t, cfg.CORSAllowCredentials)
+	assert.Equal(t, 10*time.Minute, cfg.CORSMaxAge)
+	assert.Empty(t, cfg.TrustedProxies)
+	assert.True(t, cfg.RateLimitEnabled)
+	assert.Equal(t, "memory", cfg.RateLimitStore)
+	assert.Equal(t, 20.0, cfg.RateLimitAPIRate)
+	assert.Equal(t, 40, cfg.RateLimitAPIBurst)
+	assert.Equal(t, 5.0, cfg.RateLimitItemRate)
+	assert.Equal(t, 10, cfg.RateLimitItemBurst)
+	assert.Empty(t, cfg.RateLimitAPIKeys)
+	assert.True(t, cfg.ItemCacheEnabled)
+	assert.Equal(t, 1000, cfg.ItemCacheSize)
+	assert.Equal(t, time.Minute, cfg.ItemCacheTTL)
+	assert.Equal(t, 30*time.Second, cfg.ItemCacheMaxAge)
+	assert.Empty(t, cfg.AdminToken)
+	assert.True(t, cfg.MetricsEnabled)
+	assert.Equal(t, "none", cfg.TracingExporter)
+	assert.Equal(t, 1.0, cfg.TracingSampleRatio)
+}
+
+func TestConfig_Load_PortAlias(t *testing.T) {
+	t.Setenv("PORT", "7000")
+
+	cfg, err := Load(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "7000", cfg.HTTPPort)
+
+	t.Setenv("HTTP_PORT", "7001")
+
+	cfg, err = Load(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "7001", cfg.HTTPPort)
+}
+
+func TestConfig_Load_Precedence(t *testing.T) {
+	dir := t.TempDir()
+
+	configFile := filepath.Join(dir, "config.yaml")
+	writeFile(t, configFile, `
+http:
+  port: 7001
+  read_timeout: 5s
+db:
+  host: file-host
+  name: file-db
+log_level: debug
+cors_allowed_origins:
+  - https://a.example.com
+  - https://b.example.com
+`)
+	envFile := filepath.Join(dir, ".env")
+	writeFile(t, envFile, "HTTP_PORT=7002\nDB_HOST=dotenv-host\n")
+
+	t.Setenv("HTTP_PORT", "7003")
+
+	cfg, err := Load([]string{"--config", configFile, "--env-file", envFile, "--http-port", "7004"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "7004", cfg.HTTPPort)               // flag
+	assert.Equal(t, "dotenv-host", cfg.DBHost)          // .env over file
+	assert.Equal(t, "file-db", cfg.DBName)              // file over default
+	assert.Equal(t, 5*time.Second, cfg.HTTPReadTimeout) // file
+	assert.Equal(t, "debug", cfg.LogLevel)
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, cfg.CORSAllowedOrigins)
+}
+
+func TestConfig_Load_EmptyValuesOverrideLowerLayers(t *testing.T) {
+	dir := t.TempDir()
+
+	configFile := filepath.Join(dir, "config.yaml")
+	writeFile(t, configFile, `
+log_file: /var/log/meli.log
+admin_token: file-token
+trusted_proxies: [10.0.0.1]
+cors_allowed_origins: [https://a.example.com]
+rate_limit_api_keys: [file-key]
+`)
+	envFile := filepath.Join(dir, ".env")
+	writeFile(t, envFile, "TRUSTED_PROXIES=\n")
+
+	t.Setenv("LOG_FILE", "")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "")
+
+	cfg, err := Load([]string{"--config", configFile, "--env-file", envFile, "--admin-token=", "--rate-limit-api-keys="})
+
+	assert.NoError(t, err)
+	assert.Empty(t, cfg.LogFile)            // env
+	assert.Empty(t, cfg.AdminToken)         // flag
+	assert.Empty(t, cfg.TrustedProxies)     // .env
+	assert.Empty(t, cfg.CORSAllowedOrigins) // env, not the default
+	assert.Empty(t, cfg.RateLimitAPIKeys)   // flag
+}
+
+func TestConfig_Load_EmptyRequiredValues(t *testing.T) {
+	t.Setenv("RATE_LIMIT_STORE", "redis")
+	t.Setenv("RATE_LIMIT_REDIS_ADDR", "")
+	t.Setenv("TRACING_EXPORTER", "otlp")
+	t.Setenv("TRACING_OTLP_ENDPOINT", "")
+	t.Setenv("ITEM_CACHE_TTL", "")
+
+	_, err := Load(nil)
+
+	assert.ErrorContains(t, err, "RATE_LIMIT_REDIS_ADDR is required")
+	assert.ErrorContains(t, err, "TRACING_OTLP_ENDPOINT is required")
+	assert.ErrorContains(t, err, `ITEM_CACHE_TTL must be a positive duration such as 15s or 1m, got ""`)
+}
+
+func TestConfig_Load_EmptyValueKeepsAlias(t *testing.T) {
+	t.Setenv("HTTP_PORT", "")
+	t.Setenv("PORT", "7000")
+
+	cfg, err := Load(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "7000", cfg.HTTPPort)
+}
+
+func TestConfig_Load_TOMLFile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.toml")
+	writeFile(t, configFile, `
+gin_mode = "debug"
+
+[db]
+max_open_conns = 20
+max_idle_conns = 5
+`)
+	t.Setenv("CONFIG_FILE", configFile)
+
+	cfg, err := Load(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "debug", cfg.GinMode)
+	assert.Equal(t, 20, cfg.DBMaxOpenConns)
+	assert.Equal(t, 5, cfg.DBMaxIdleConns)
+}
+
+func TestConfig_Load_UnknownFileSetting(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, configFile, "http_prot: 8080\n")
+
+	_, err := Load([]string{"--config", configFile})
+
+	assert.ErrorContains(t, err, "unknown settings: HTTP_PROT")
+}
+
+func TestConfig_Load_MissingExplicitFiles(t *testing.T) {
+	_, err := Load([]string{"--config", "missing.yaml"})
+	assert.ErrorContains(t, err, "reading config file")
+
+	_, err = Load([]string{"--env-file", "missing.env"})
+	assert.ErrorContains(t, err, "reading env file")
+}
+
+func TestConfig_Load_UnknownFlag(t *testing.T) {
+	_, err := Load([]string{"--htp-port", "80"})
+
+	assert.Error(t, err)
+}
+
+func TestConfig_Load_CORSOrigins(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com/, https://*.example.com, http://localhost:3000")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "true")
+
+	cfg, err := Load(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://app.example.com", "https://*.example.com", "http://localhost:3000"}, cfg.CORSAllowedOrigins)
+	assert.True(t, cfg.CORSAllowCredentials)
+}
+
+func TestConfig_Load_CORSInvalidWildcard(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.*.example.com")
+
+	_, err := Load(nil)
+
+	assert.ErrorContains(t, err, `CORS_ALLOWED_ORIGINS must contain origins such as https://example.com, https://*.example.com or *, got "https://app.*.example.com"`)
+}
+
+func TestConfig_Load_CORSCredentialsWithAnyOrigin(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", "*")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "true")
+
+	_, err := Load(nil)
+
+	assert.ErrorContains(t, err, "CORS_ALLOW_CREDENTIALS cannot be enabled when CORS_ALLOWED_ORIGINS contains *")
+}
+
+func TestConfig_Load_RateLimit(t *testing.T) {
+	t.Setenv("RATE_LIMIT_API_RATE", "600/m")
+	t.Setenv("RATE_LIMIT_ITEM_RATE", "1800/h")
+	t.Setenv("RATE_LIMIT_API_KEYS", "key-a, key-b")
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8, 192.168.1.1")
+
+	cfg, err := Load(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, cfg.RateLimitAPIRate)
+	assert.Equal(t, 0.5, cfg.RateLimitItemRate)
+	assert.Equal(t, []string{"key-a", "key-b"}, cfg.RateLimitAPIKeys)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.1"}, cfg.TrustedProxies)
+}
+
+func TestConfig_Load_ReportsEveryInvalidValue(t *testing.T) {
+	t.Setenv("HTTP_PORT", "http")
+	t.Setenv("LOG_LEVEL", "verbose")
+	t.Setenv("HTTP_WRITE_TIMEOUT", "10")
+	t.Setenv("DB_MAX_OPEN_CONNS", "5")
+	t.Setenv("DB_MAX_IDLE_CONNS", "10")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "localhost:3000")
+	t.Setenv("LOG_MAX_BACKUPS", "-1")
+	t.Setenv("METRICS_ENABLED", "maybe")
+	t.Setenv("TRACING_EXPORTER", "jaeger")
+	t.Setenv("TRACING_SAMPLE_RATIO", "1.5")
+	t.Setenv("RATE_LIMIT_API_RATE", "20")
+	t.Setenv("RATE_LIMIT_STORE", "memcached")
+	t.Setenv("TRUSTED_PROXIES", "proxy.local")
+	t.Setenv("ITEM_CACHE_SIZE", "0")
+
+	_, err := Load(nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, `HTTP_PORT must be a port number between 1 and 65535, got "http"`)
+	assert.ErrorContains(t, err, `LOG_LEVEL must be one of debug, info, warn, error, got "verbose"`)
+	assert.ErrorContains(t, err, `HTTP_WRITE_TIMEOUT must be a positive duration`)
+	assert.ErrorContains(t, err, `DB_MAX_IDLE_CONNS must not be greater than DB_MAX_OPEN_CONNS (5), got 10`)
+	assert.ErrorContains(t, err, `CORS_ALLOWED_ORIGINS must contain origins`)
+	assert.ErrorContains(t, err, `LOG_MAX_BACKUPS must be zero or a positive integer, got "-1"`)
+	assert.ErrorContains(t, err, `METRICS_ENABLED must be true or false, got "maybe"`)
+	assert.ErrorContains(t, err, `TRACING_EXPORTER must be one of none, stdout, otlp, got "jaeger"`)
+	assert.ErrorContains(t, err, `TRACING_SAMPLE_RATIO must be a number between 0 and 1, got "1.5"`)
+	assert.ErrorContains(t, err, `RATE_LIMIT_API_RATE must be a rate such as 20/s, 600/m or 1000/h, got "20"`)
+	assert.ErrorContains(t, err, `RATE_LIMIT_STORE must be one of memory, redis, got "memcached"`)
+	assert.ErrorContains(t, err, `ITEM_CACHE_SIZE must be a positive integer, got "0"`)
+	assert.ErrorContains(t, err, `TRUSTED_PROXIES must contain IPs or CIDRs such as 10.0.0.0/8, got "proxy.local"`)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
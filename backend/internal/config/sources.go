@@ -0,0 +1,223 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEnvFile = ".env"
+
+// resolve returns the final raw value of every setting.
+func resolve(args []string) (map[string]string, error) {
+	flags, configFile, envFile, err := parseFlags(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dotEnv, err := readDotEnv(envFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if !configFile.explicit {
+		if value, ok := lookup(os.LookupEnv, "CONFIG_FILE"); ok {
+			configFile.value = value
+		} else {
+			configFile.value = dotEnv["CONFIG_FILE"]
+		}
+	}
+	file, err := readConfigFile(configFile.value)
+	if err != nil {
+		return nil, err
+	}
+
+	// the highest layer setting a key wins, even with an empty value; the
+	// default only applies when no layer sets it
+	values := make(map[string]string, len(settings))
+	for _, s := range settings {
+		keys := append([]string{s.key}, s.aliases...)
+
+		value, ok := flags[s.key]
+		if !ok {
+			value, ok = firstOf(keys, os.LookupEnv)
+		}
+		if !ok {
+			value, ok = firstOf(keys, mapLookup(dotEnv))
+		}
+		if !ok {
+			value, ok = file[s.key]
+		}
+		if !ok {
+			value = s.def
+		}
+		values[s.key] = value
+	}
+	return values, nil
+}
+
+type pathFlag struct {
+	value    string
+	explicit bool
+}
+
+func parseFlags(args []string) (map[string]string, pathFlag, pathFlag, error) {
+	fs := flag.NewFlagSet("meli-backend", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	configFile := fs.String("config", "", "YAML or TOML config file")
+	envFile := fs.String("env-file", defaultEnvFile, ".env file")
+	for _, s := range settings {
+		fs.String(flagName(s.key), "", s.usage)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stderr)
+			fs.PrintDefaults()
+		}
+		return nil, pathFlag{}, pathFlag{}, err
+	}
+
+	flags := map[string]string{}
+	explicit := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+		for _, s := range settings {
+			if flagName(s.key) == f.Name {
+				flags[s.key] = f.Value.String()
+			}
+		}
+	})
+
+	return flags,
+		pathFlag{value: *configFile, explicit: explicit["config"]},
+		pathFlag{value: *envFile, explicit: explicit["env-file"]},
+		nil
+}
+
+// readDotEnv reads the .env file without touching the process environment, so
+// real environment variables keep precedence. A missing file is only an error
+// when it was asked for explicitly.
+func readDotEnv(path pathFlag) (map[string]string, error) {
+	values, err := godotenv.Read(path.value)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !path.explicit {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("reading env file %s: %w", path.value, err)
+	}
+	return values, nil
+}
+
+func readConfigFile(path string) (map[string]string, error) {
+	if path == "" {
+		return map[string]string{}, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	raw := map[string]interface{}{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &raw)
+	case ".toml":
+		err = toml.Unmarshal(content, &raw)
+	default:
+		return nil, fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	values := map[string]string{}
+	flatten("", raw, values)
+
+	var unknown []string
+	for key := range values {
+		if !isSetting(key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("config file %s has unknown settings: %s", path, strings.Join(unknown, ", "))
+	}
+	return values, nil
+}
+
+// flatten turns nested keys into setting names, so "db: {host: x}" and
+// "db_host: x" both set DB_HOST. Lists become comma separated values.
+func flatten(prefix string, raw map[string]interface{}, values map[string]string) {
+	for key, value := range raw {
+		name := strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flatten(name, v, values)
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[name] = strings.Join(items, ",")
+		case nil:
+		default:
+			values[name] = fmt.Sprint(v)
+		}
+	}
+}
+
+func isSetting(key string) bool {
+	for _, s := range settings {
+		if s.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func flagName(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", "-"))
+}
+
+func lookup(get func(string) (string, bool), key string) (string, bool) {
+	value, ok := get(key)
+	return strings.TrimSpace(value), ok
+}
+
+// firstOf returns the first non-empty value of keys, aliases coming after
+// the setting key, and whether any of them is set.
+func firstOf(keys []string, get func(string) (string, bool)) (string, bool) {
+	set := false
+	for _, key := range keys {
+		value, ok := lookup(get, key)
+		if value != "" {
+			return value, true
+		}
+		set = set || ok
+	}
+	return "", set
+}
+
+func mapLookup(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
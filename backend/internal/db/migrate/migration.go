@@ -0,0 +1,132 @@
+// Package migrate applies the dbmate-format migrations embedded in the binary,
+// recording them in the same schema_migrations table dbmate uses, so
+// databases migrated by either tool stay interchangeable.
+package migrate
+
+import (
+	"fmt"
+	"io/fs"
+	"meli-backend/internal/db/migrations"
+	"sort"
+	"strings"
+)
+
+const (
+	upMarker   = "-- migrate:up"
+	downMarker = "-- migrate:down"
+)
+
+// Migration is a parsed migration file.
+type Migration struct {
+	Version string
+	Name    string
+	Up      Section
+	Down    Section
+}
+
+// Section is the up or down half of a migration. Like dbmate, it runs in a
+// transaction unless its marker says `transaction:false`, which statements
+// such as CREATE INDEX CONCURRENTLY need.
+type Section struct {
+	SQL         string
+	Transaction bool
+}
+
+// Load parses every migration file of fsys, in version order.
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	loaded := make([]Migration, 0, len(files))
+	for i, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		migration, err := Parse(file, string(content))
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && loaded[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("migrations %s and %s share version %s", loaded[i-1].Name, file, migration.Version)
+		}
+		loaded = append(loaded, migration)
+	}
+	return loaded, nil
+}
+
+// Parse splits the content of the migration file name into its
+// `-- migrate:up` and `-- migrate:down` sections. The up section is required
+// and must come first; the down section may be missing or empty, in which
+// case the migration cannot be rolled back.
+func Parse(name, content string) (Migration, error) {
+	migration := Migration{Version: migrations.Version(name), Name: name}
+
+	var current *Section
+	var seenUp, seenDown bool
+	var body strings.Builder
+	flush := func() {
+		if current != nil {
+			current.SQL = strings.TrimSpace(body.String())
+		}
+		body.Reset()
+	}
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		marker, options, isMarker := parseMarker(line)
+		if !isMarker {
+			if current == nil && strings.TrimSpace(line) != "" && !strings.HasPrefix(strings.TrimSpace(line), "--") {
+				return Migration{}, fmt.Errorf("migration %s: statement before %q", name, upMarker)
+			}
+			body.WriteString(line)
+			continue
+		}
+
+		flush()
+		switch {
+		case marker == upMarker && !seenUp && !seenDown:
+			seenUp, current = true, &migration.Up
+		case marker == downMarker && seenUp && !seenDown:
+			seenDown, current = true, &migration.Down
+		default:
+			return Migration{}, fmt.Errorf("migration %s: unexpected %q", name, marker)
+		}
+
+		current.Transaction = true
+		for _, option := range options {
+			switch option {
+			case "transaction:true":
+			case "transaction:false":
+				current.Transaction = false
+			default:
+				return Migration{}, fmt.Errorf("migration %s: unknown option %q", name, option)
+			}
+		}
+	}
+	flush()
+
+	if !seenUp {
+		return Migration{}, fmt.Errorf("migration %s: missing %q", name, upMarker)
+	}
+	if migration.Up.SQL == "" {
+		return Migration{}, fmt.Errorf("migration %s: empty up section", name)
+	}
+	return migration, nil
+}
+
+// parseMarker reports whether line is a section marker, returning it and its
+// options.
+func parseMarker(line string) (string, []string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "--" {
+		return "", nil, false
+	}
+	marker := "-- " + fields[1]
+	if marker != upMarker && marker != downMarker {
+		return "", nil, false
+	}
+	return marker, fields[2:], true
+}
@@ -0,0 +1,91 @@
+package migrate
+
+import (
+	"meli-backend/internal/db/migrations"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	migration, err := Parse("007_orders.sql", `-- migrate:up
+-- orders of the checkout
+CREATE TABLE orders (id UUID PRIMARY KEY);
+
+-- migrate:down
+DROP TABLE orders;
+`)
+
+	require.NoError(t, err)
+	assert.Equal(t, "007", migration.Version)
+	assert.Equal(t, "007_orders.sql", migration.Name)
+	assert.Equal(t, Section{SQL: "-- orders of the checkout\nCREATE TABLE orders (id UUID PRIMARY KEY);", Transaction: true}, migration.Up)
+	assert.Equal(t, Section{SQL: "DROP TABLE orders;", Transaction: true}, migration.Down)
+}
+
+func TestParse_TransactionOption(t *testing.T) {
+	migration, err := Parse("007_index.sql", `-- migrate:up transaction:false
+CREATE INDEX CONCURRENTLY idx_items_title ON items(title);
+-- migrate:down transaction:false
+DROP INDEX CONCURRENTLY idx_items_title;
+`)
+
+	require.NoError(t, err)
+	assert.False(t, migration.Up.Transaction)
+	assert.False(t, migration.Down.Transaction)
+}
+
+func TestParse_WithoutDown(t *testing.T) {
+	migration, err := Parse("007_orders.sql", "-- migrate:up\nSELECT 1;\n")
+
+	require.NoError(t, err)
+	assert.Empty(t, migration.Down.SQL)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		err     string
+	}{
+		"missing up":       {"-- migrate:down\nSELECT 1;", `unexpected "-- migrate:down"`},
+		"empty":            {"-- just a comment\n", `missing "-- migrate:up"`},
+		"empty up":         {"-- migrate:up\n\n-- migrate:down\nSELECT 1;", "empty up section"},
+		"down before up":   {"-- migrate:down\n-- migrate:up\nSELECT 1;", `unexpected "-- migrate:down"`},
+		"repeated up":      {"-- migrate:up\nSELECT 1;\n-- migrate:up\nSELECT 2;", `unexpected "-- migrate:up"`},
+		"statement before": {"SELECT 0;\n-- migrate:up\nSELECT 1;", `statement before "-- migrate:up"`},
+		"unknown option":   {"-- migrate:up transaction:maybe\nSELECT 1;", `unknown option "transaction:maybe"`},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse("007_broken.sql", test.content)
+			assert.ErrorContains(t, err, "migration 007_broken.sql: "+test.err)
+		})
+	}
+}
+
+func TestLoad_EmbeddedMigrations(t *testing.T) {
+	loaded, err := Load(migrations.FS)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+	assert.Equal(t, "001", loaded[0].Version)
+	assert.Equal(t, migrations.Latest(), loaded[len(loaded)-1].Version)
+	for _, migration := range loaded {
+		assert.True(t, migration.Up.Transaction, migration.Name)
+		assert.NotEmpty(t, migration.Down.SQL, migration.Name)
+		// the runner owns the transaction, as dbmate does
+		assert.NotContains(t, migration.Up.SQL, "BEGIN;", migration.Name)
+		assert.NotContains(t, migration.Up.SQL, "COMMIT;", migration.Name)
+	}
+}
+
+func TestLoad_DuplicateVersion(t *testing.T) {
+	_, err := Load(fstest.MapFS{
+		"007_orders.sql": {Data: []byte("-- migrate:up\nSELECT 1;")},
+		"007_carts.sql":  {Data: []byte("-- migrate:up\nSELECT 2;")},
+	})
+
+	assert.ErrorContains(t, err, "migrations 007_carts.sql and 007_orders.sql share version 007")
+}
@@ -0,0 +1,203 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// lockKey identifies the advisory lock held while migrating. It is shared by
+// every replica, so only one of them migrates at a time.
+const lockKey int64 = 0x6d656c692d6d6967 // "meli-mig"
+
+const (
+	createSchemaTable = `CREATE TABLE IF NOT EXISTS schema_migrations (version VARCHAR(128) PRIMARY KEY)`
+	insertVersion     = `INSERT INTO schema_migrations (version) VALUES ($1)`
+	deleteVersion     = `DELETE FROM schema_migrations WHERE version = $1`
+)
+
+// ErrNothingToRollback is returned by Down and Redo when no migration has
+// been applied.
+var ErrNothingToRollback = errors.New("no migration has been applied")
+
+// Status is a migration and whether it has been applied.
+type Status struct {
+	Migration
+	Applied bool
+}
+
+// Migrator applies migrations to a Postgres database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     *slog.Logger
+}
+
+// New returns a Migrator applying migrations, as returned by Load, to db.
+func New(db *sql.DB, migrations []Migration, logger *slog.Logger) *Migrator {
+	return &Migrator{db: db, migrations: migrations, logger: logger}
+}
+
+// Up applies every pending migration in version order and returns them. A
+// failing migration is rolled back and stops the run.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.locked(ctx, func(conn *sql.Conn, versions map[string]bool) error {
+		for _, migration := range m.migrations {
+			if versions[migration.Version] {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, migration.Up, insertVersion); err != nil {
+				return err
+			}
+			m.logger.Info("migration applied", "migration", migration.Name)
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the newest applied migration and returns it.
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var rolledBack Migration
+	err := m.locked(ctx, func(conn *sql.Conn, versions map[string]bool) error {
+		var err error
+		rolledBack, err = m.down(ctx, conn, versions)
+		return err
+	})
+	return rolledBack, err
+}
+
+// Redo rolls back the newest applied migration and applies it again, handy
+// while writing one.
+func (m *Migrator) Redo(ctx context.Context) (Migration, error) {
+	var redone Migration
+	err := m.locked(ctx, func(conn *sql.Conn, versions map[string]bool) error {
+		var err error
+		if redone, err = m.down(ctx, conn, versions); err != nil {
+			return err
+		}
+		if err := m.apply(ctx, conn, redone, redone.Up, insertVersion); err != nil {
+			return err
+		}
+		m.logger.Info("migration applied", "migration", redone.Name)
+		return nil
+	})
+	return redone, err
+}
+
+// Status returns every migration with whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.locked(ctx, func(conn *sql.Conn, versions map[string]bool) error {
+		for _, migration := range m.migrations {
+			statuses = append(statuses, Status{Migration: migration, Applied: versions[migration.Version]})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn, versions map[string]bool) (Migration, error) {
+	index := len(m.migrations) - 1
+	for index >= 0 && !versions[m.migrations[index].Version] {
+		index--
+	}
+	if index < 0 {
+		return Migration{}, ErrNothingToRollback
+	}
+	migration := m.migrations[index]
+	if migration.Down.SQL == "" {
+		return Migration{}, fmt.Errorf("migration %s has no down section", migration.Name)
+	}
+	if err := m.apply(ctx, conn, migration, migration.Down, deleteVersion); err != nil {
+		return Migration{}, err
+	}
+	m.logger.Info("migration rolled back", "migration", migration.Name)
+	return migration, nil
+}
+
+// apply runs section of migration and then record, which adds or removes its
+// version, both in one transaction unless the section opts out.
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration, section Section, record string) error {
+	if !section.Transaction {
+		if _, err := conn.ExecContext(ctx, section.SQL); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.Name, err)
+		}
+		if _, err := conn.ExecContext(ctx, record, migration.Version); err != nil {
+			return fmt.Errorf("migration %s: recording version: %w", migration.Name, err)
+		}
+		return nil
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", migration.Name, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, section.SQL); err != nil {
+		return fmt.Errorf("migration %s: %w", migration.Name, err)
+	}
+	if _, err := tx.ExecContext(ctx, record, migration.Version); err != nil {
+		return fmt.Errorf("migration %s: recording version: %w", migration.Name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("migration %s: %w", migration.Name, err)
+	}
+	return nil
+}
+
+// locked runs fn on a single connection holding the migration advisory lock,
+// with the versions applied so far. The lock is session-level, so it spans
+// the transactions of every migration fn applies.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, versions map[string]bool) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquiring migration lock: %w", err)
+	}
+	defer func() {
+		// a fresh context, so the lock is released even if ctx was canceled
+		if _, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); unlockErr != nil {
+			err = errors.Join(err, fmt.Errorf("releasing migration lock: %w", unlockErr))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createSchemaTable); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return err
+	}
+	return fn(conn, versions)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[string]bool, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("reading schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	versions := map[string]bool{}
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("reading schema_migrations: %w", err)
+		}
+		versions[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading schema_migrations: %w", err)
+	}
+	return versions, nil
+}
@@ -0,0 +1,223 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"meli-backend/internal/db/migrations"
+	"os"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMigrations = []Migration{
+	{Version: "001", Name: "001_orders.sql",
+		Up:   Section{SQL: "CREATE TABLE orders (id INT);", Transaction: true},
+		Down: Section{SQL: "DROP TABLE orders;", Transaction: true}},
+	{Version: "002", Name: "002_orders_index.sql",
+		Up:   Section{SQL: "CREATE INDEX CONCURRENTLY idx_orders ON orders(id);"},
+		Down: Section{SQL: "DROP INDEX CONCURRENTLY idx_orders;"}},
+}
+
+func newMockMigrator(t *testing.T, migrations []Migration) (*Migrator, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return New(db, migrations, slog.New(slog.NewTextHandler(io.Discard, nil))), mock
+}
+
+// expectLocked expects the lock and the read of the applied versions that
+// start every command.
+func expectLocked(mock sqlmock.Sqlmock, applied ...string) {
+	mock.ExpectExec("SELECT pg_advisory_lock($1)").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createSchemaTable).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version"})
+	for _, version := range applied {
+		rows.AddRow(version)
+	}
+	mock.ExpectQuery("SELECT version FROM schema_migrations").WillReturnRows(rows)
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec("SELECT pg_advisory_unlock($1)").WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestMigrator_Up(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE orders (id INT);").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insertVersion).WithArgs("001").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	// transaction:false runs straight on the connection
+	mock.ExpectExec("CREATE INDEX CONCURRENTLY idx_orders ON orders(id);").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insertVersion).WithArgs("002").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectUnlock(mock)
+
+	applied, err := m.Up(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, testMigrations, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Up_SkipsApplied(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock, "001", "002")
+	expectUnlock(mock)
+
+	applied, err := m.Up(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Up_FailureRollsBack(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE orders (id INT);").WillReturnError(errors.New("relation already exists"))
+	mock.ExpectRollback()
+	expectUnlock(mock)
+
+	applied, err := m.Up(context.Background())
+
+	assert.EqualError(t, err, "migration 001_orders.sql: relation already exists")
+	assert.Empty(t, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations[:1])
+	expectLocked(mock, "001")
+	mock.ExpectBegin()
+	mock.ExpectExec("DROP TABLE orders;").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(deleteVersion).WithArgs("001").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlock(mock)
+
+	migration, err := m.Down(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "001_orders.sql", migration.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down_NothingApplied(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock)
+	expectUnlock(mock)
+
+	_, err := m.Down(context.Background())
+
+	assert.ErrorIs(t, err, ErrNothingToRollback)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down_WithoutDownSection(t *testing.T) {
+	m, mock := newMockMigrator(t, []Migration{{Version: "001", Name: "001_orders.sql", Up: testMigrations[0].Up}})
+	expectLocked(mock, "001")
+	expectUnlock(mock)
+
+	_, err := m.Down(context.Background())
+
+	assert.EqualError(t, err, "migration 001_orders.sql has no down section")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Redo(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock, "001")
+	mock.ExpectBegin()
+	mock.ExpectExec("DROP TABLE orders;").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(deleteVersion).WithArgs("001").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE orders (id INT);").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(insertVersion).WithArgs("001").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlock(mock)
+
+	migration, err := m.Redo(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "001", migration.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Status(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	expectLocked(mock, "001")
+	expectUnlock(mock)
+
+	statuses, err := m.Status(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []Status{
+		{Migration: testMigrations[0], Applied: true},
+		{Migration: testMigrations[1], Applied: false},
+	}, statuses)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_LockFailure(t *testing.T) {
+	m, mock := newMockMigrator(t, testMigrations)
+	mock.ExpectExec("SELECT pg_advisory_lock($1)").WithArgs(lockKey).WillReturnError(errors.New("connection reset"))
+
+	_, err := m.Up(context.Background())
+
+	assert.EqualError(t, err, "acquiring migration lock: connection reset")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestMigrator_Postgres applies, rolls back and reapplies the embedded
+// migrations on a real database. It needs TEST_DATABASE_URL pointing at a
+// scratch database, which it leaves migrated.
+func TestMigrator_Postgres(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	loaded, err := Load(migrations.FS)
+	require.NoError(t, err)
+	m := New(db, loaded, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	_, err = m.Redo(ctx)
+	require.NoError(t, err)
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.True(t, status.Applied, status.Name)
+	}
+
+	// concurrent runs wait for the lock, so the rolled back migration is
+	// applied once
+	_, err = m.Down(ctx)
+	require.NoError(t, err)
+	results := make(chan []Migration, 2)
+	for range 2 {
+		go func() {
+			applied, err := m.Up(ctx)
+			assert.NoError(t, err)
+			results <- applied
+		}()
+	}
+	assert.Len(t, append(<-results, <-results...), 1)
+}
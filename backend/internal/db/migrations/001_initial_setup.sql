@@ -1,12 +1,8 @@
 -- migrate:up
 
-BEGIN;
-
-
 CREATE TYPE product_status_enum AS ENUM ('New', 'Used', 'Acondicionado');
 CREATE TYPE payment_type_enum   AS ENUM ('credit', 'debit', 'other', 'transfer');
 
-
 CREATE TABLE images (
     id UUID PRIMARY KEY,
     url_small_version  TEXT,
@@ -147,10 +143,7 @@ CREATE INDEX idx_reviews_seller  ON reviews(seller_id);
 
 CREATE INDEX idx_questions_item ON questions(item_id);
 
-COMMIT;
-
 -- migrate:down
-BEGIN;
 
 -- Drop dependents first (reverse order of creation / FK dependencies)
 DROP INDEX IF EXISTS idx_questions_item;
@@ -193,5 +186,3 @@ DROP TABLE IF EXISTS images;
 -- Enums last
 DROP TYPE IF EXISTS payment_type_enum;
 DROP TYPE IF EXISTS product_status_enum;
-
-COMMIT;
@@ -0,0 +1,21 @@
+-- migrate:up
+
+-- items need a creation timestamp so the listing can be sorted by "newest"
+ALTER TABLE items ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT now();
+
+-- ===========================
+--   Listing Indexes
+-- ===========================
+CREATE INDEX idx_items_created_at     ON items(created_at, item_id);
+CREATE INDEX idx_items_product_status ON items(product_status);
+CREATE INDEX idx_products_family      ON products(family_id);
+CREATE INDEX idx_prices_value         ON prices(value, id);
+
+-- migrate:down
+
+DROP INDEX IF EXISTS idx_prices_value;
+DROP INDEX IF EXISTS idx_products_family;
+DROP INDEX IF EXISTS idx_items_product_status;
+DROP INDEX IF EXISTS idx_items_created_at;
+
+ALTER TABLE items DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,84 @@
+-- migrate:up
+
+-- Spanish stemming with accent folding: "cámara" and "camara" match each other.
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE TEXT SEARCH CONFIGURATION es_unaccent (COPY = spanish);
+ALTER TEXT SEARCH CONFIGURATION es_unaccent
+    ALTER MAPPING FOR hword, hword_part, word
+    WITH unaccent, spanish_stem;
+
+-- spec_search_text flattens the values of products.main_spec / secondary_spec
+-- into plain text. Labels and icon URLs are left out on purpose.
+CREATE FUNCTION spec_search_text(main_spec JSONB, secondary_spec JSONB) RETURNS TEXT AS $$
+    SELECT concat_ws(' ',
+        (SELECT string_agg(spec->>'value', ' ')
+           FROM jsonb_array_elements(CASE WHEN jsonb_typeof(main_spec) = 'array' THEN main_spec ELSE '[]'::jsonb END) AS spec),
+        (SELECT string_agg(spec_value->>'value', ' ')
+           FROM jsonb_array_elements(CASE WHEN jsonb_typeof(secondary_spec) = 'array' THEN secondary_spec ELSE '[]'::jsonb END) AS spec_group,
+                jsonb_array_elements(CASE WHEN jsonb_typeof(spec_group->'values') = 'array' THEN spec_group->'values' ELSE '[]'::jsonb END) AS spec_value)
+    )
+$$ LANGUAGE sql IMMUTABLE;
+
+ALTER TABLE items ADD COLUMN search_vector tsvector;
+
+-- Title weighs the most, then the product specs, then the description.
+CREATE FUNCTION items_search_vector_refresh() RETURNS trigger AS $$
+DECLARE
+    specs TEXT;
+BEGIN
+    SELECT spec_search_text(pr.main_spec, pr.secondary_spec)
+      INTO specs
+      FROM user_products up
+      JOIN products pr ON pr.id = up.product_id
+     WHERE up.id = NEW.user_product_id;
+
+    NEW.search_vector :=
+        setweight(to_tsvector('es_unaccent', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('es_unaccent', COALESCE(specs, '')), 'B') ||
+        setweight(to_tsvector('es_unaccent', COALESCE(NEW.description, '')), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_items_search_vector
+    BEFORE INSERT OR UPDATE OF title, description, user_product_id ON items
+    FOR EACH ROW EXECUTE FUNCTION items_search_vector_refresh();
+
+-- Spec changes on a product must be reflected in every item that sells it.
+CREATE FUNCTION products_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    UPDATE items i
+       SET title = i.title
+      FROM user_products up
+     WHERE up.id = i.user_product_id
+       AND up.product_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_search_vector
+    AFTER UPDATE OF main_spec, secondary_spec ON products
+    FOR EACH ROW EXECUTE FUNCTION products_search_vector_refresh();
+
+-- Backfill existing rows through the trigger.
+UPDATE items SET title = title;
+
+CREATE INDEX idx_items_search_vector ON items USING GIN (search_vector);
+
+-- migrate:down
+
+DROP INDEX IF EXISTS idx_items_search_vector;
+
+DROP TRIGGER IF EXISTS trg_products_search_vector ON products;
+DROP FUNCTION IF EXISTS products_search_vector_refresh();
+
+DROP TRIGGER IF EXISTS trg_items_search_vector ON items;
+DROP FUNCTION IF EXISTS items_search_vector_refresh();
+
+ALTER TABLE items DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS spec_search_text(JSONB, JSONB);
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS es_unaccent;
+DROP EXTENSION IF EXISTS unaccent;
@@ -0,0 +1,13 @@
+-- migrate:up
+
+-- answered_at lets the UI show how long the seller took to answer.
+-- Questions answered before this migration keep a NULL answered_at.
+ALTER TABLE questions ADD COLUMN answered_at TIMESTAMP;
+
+CREATE INDEX idx_questions_item_created_at ON questions(item_id, created_at, id);
+
+-- migrate:down
+
+DROP INDEX IF EXISTS idx_questions_item_created_at;
+
+ALTER TABLE questions DROP COLUMN IF EXISTS answered_at;
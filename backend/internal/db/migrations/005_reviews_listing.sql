@@ -0,0 +1,12 @@
+-- migrate:up
+
+-- Keyset pagination of reviews per item and per product.
+CREATE INDEX idx_reviews_item_created_at    ON reviews(item_id, created_at, id);
+CREATE INDEX idx_reviews_product_created_at ON reviews(product_id, created_at, id);
+CREATE INDEX idx_reviews_product_rating     ON reviews(product_id, rating, created_at, id);
+
+-- migrate:down
+
+DROP INDEX IF EXISTS idx_reviews_product_rating;
+DROP INDEX IF EXISTS idx_reviews_product_created_at;
+DROP INDEX IF EXISTS idx_reviews_item_created_at;
@@ -0,0 +1,16 @@
+-- migrate:up
+
+-- Local exchange rates used to convert item prices (?currency=). One row
+-- converts one unit of base_currency into rate units of quote_currency.
+CREATE TABLE exchange_rates (
+    base_currency  VARCHAR(10) NOT NULL,
+    quote_currency VARCHAR(10) NOT NULL,
+    rate           NUMERIC(24,10) NOT NULL CHECK (rate > 0),
+    updated_at     TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (base_currency, quote_currency),
+    CHECK (base_currency <> quote_currency)
+);
+
+-- migrate:down
+
+DROP TABLE IF EXISTS exchange_rates;
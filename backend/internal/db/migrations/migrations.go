@@ -0,0 +1,30 @@
+// Package migrations embeds the dbmate-format migrations, so the binary can
+// apply them (see package migrate) and knows which schema version it expects.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// Latest returns the version of the newest migration, the file name prefix
+// dbmate stores in schema_migrations (e.g. "006" for 006_exchange_rates.sql).
+func Latest() string {
+	files, err := fs.Glob(FS, "*.sql")
+	if err != nil || len(files) == 0 {
+		return ""
+	}
+	sort.Strings(files)
+	return Version(files[len(files)-1])
+}
+
+// Version returns the dbmate version of a migration file name.
+func Version(name string) string {
+	version, _, _ := strings.Cut(name, "_")
+	return strings.TrimSuffix(version, ".sql")
+}
@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersion(t *testing.T) {
+	assert.Equal(t, "006", Version("006_exchange_rates.sql"))
+	assert.Equal(t, "20240101120000", Version("20240101120000.sql"))
+}
+
+func TestLatest(t *testing.T) {
+	assert.Equal(t, "006", Latest())
+}
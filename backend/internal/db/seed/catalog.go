@@ -0,0 +1,233 @@
+package seed
+
+// The vocabulary the synthetic catalog is drawn from. Products are built
+// from a category: a brand and model line, a value for each main spec and
+// the secondary spec groups, in the format of the sample products.
+
+const iconBaseURL = "https://http2.mlstatic.com/storage/catalog-technical-specs/images/assets/vectorial/"
+
+type category struct {
+	family    string
+	noun      string
+	brands    []brand
+	main      []specOption
+	secondary []specGroupOption
+	// price range in US dollars
+	minPrice float64
+	maxPrice float64
+}
+
+type brand struct {
+	name  string
+	lines []string
+}
+
+type specOption struct {
+	item   string
+	icon   string
+	values []string
+}
+
+type specGroupOption struct {
+	title string
+	specs []specOption
+}
+
+var colors = []string{"Negro", "Blanco", "Azul", "Gris grafito", "Plateado", "Verde menta", "Rosa", "Violeta"}
+
+var categories = []category{
+	{
+		family: "Celulares y Smartphones",
+		noun:   "Celular",
+		brands: []brand{
+			{"Samsung", []string{"Galaxy S24", "Galaxy S24+", "Galaxy A55", "Galaxy A35", "Galaxy Z Flip6"}},
+			{"Motorola", []string{"Moto G84", "Moto G54", "Edge 50 Pro", "Razr 50"}},
+			{"Xiaomi", []string{"Redmi Note 13", "Redmi 13C", "Poco X6 Pro", "14T"}},
+			{"Apple", []string{"iPhone 15", "iPhone 15 Pro", "iPhone 14"}},
+		},
+		main: []specOption{
+			{"Memoria interna", "internal_memory", []string{"128 GB", "256 GB", "512 GB"}},
+			{"Memoria RAM", "ram_memory", []string{"4 GB", "6 GB", "8 GB", "12 GB"}},
+			{"Cámara trasera principal", "rear_camera", []string{"50 Mpx", "64 Mpx", "108 Mpx", "200 Mpx"}},
+			{"Tamaño de la pantalla", "screen_size", []string{"6.1 \"", "6.4 \"", "6.7 \"", "6.8 \""}},
+		},
+		secondary: []specGroupOption{
+			{"Características generales", []specOption{
+				{"Línea", "", nil},
+				{"Es Dual SIM", "", []string{"Sí", "No"}},
+				{"Red móvil", "", []string{"4G/LTE", "5G"}},
+			}},
+			{"Batería", []specOption{
+				{"Capacidad de la batería", "", []string{"4000 mAh", "4500 mAh", "5000 mAh", "6000 mAh"}},
+				{"Con carga rápida", "", []string{"Sí", "No"}},
+			}},
+		},
+		minPrice: 150,
+		maxPrice: 1400,
+	},
+	{
+		family: "Notebooks",
+		noun:   "Notebook",
+		brands: []brand{
+			{"Lenovo", []string{"IdeaPad 3", "IdeaPad Slim 5", "ThinkPad E14", "Legion 5"}},
+			{"HP", []string{"Pavilion 15", "Victus 16", "EliteBook 840"}},
+			{"Asus", []string{"Vivobook 15", "Zenbook 14", "TUF Gaming F15"}},
+			{"Dell", []string{"Inspiron 15", "Latitude 5440", "XPS 13"}},
+		},
+		main: []specOption{
+			{"Procesador", "processor", []string{"Intel Core i5", "Intel Core i7", "AMD Ryzen 5", "AMD Ryzen 7"}},
+			{"Memoria RAM", "ram_memory", []string{"8 GB", "16 GB", "32 GB"}},
+			{"Capacidad del SSD", "internal_memory", []string{"256 GB", "512 GB", "1 TB"}},
+			{"Tamaño de la pantalla", "screen_size", []string{"14 \"", "15.6 \"", "16 \""}},
+		},
+		secondary: []specGroupOption{
+			{"Características generales", []specOption{
+				{"Línea", "", nil},
+				{"Sistema operativo", "", []string{"Windows 11 Home", "Windows 11 Pro", "FreeDOS"}},
+			}},
+			{"Pantalla", []specOption{
+				{"Resolución de la pantalla", "", []string{"1920 px x 1080 px", "2560 px x 1600 px"}},
+				{"Es táctil", "", []string{"Sí", "No"}},
+			}},
+		},
+		minPrice: 400,
+		maxPrice: 2500,
+	},
+	{
+		family: "Televisores",
+		noun:   "Smart TV",
+		brands: []brand{
+			{"Samsung", []string{"Crystal UHD", "Neo QLED", "The Frame"}},
+			{"LG", []string{"UHD ThinQ AI", "OLED evo", "NanoCell"}},
+			{"TCL", []string{"P635", "C645 QLED"}},
+		},
+		main: []specOption{
+			{"Tamaño de la pantalla", "screen_size", []string{"43 \"", "50 \"", "55 \"", "65 \""}},
+			{"Resolución", "resolution", []string{"Full HD", "4K", "8K"}},
+			{"Tipo de pantalla", "default", []string{"LED", "QLED", "OLED"}},
+		},
+		secondary: []specGroupOption{
+			{"Características generales", []specOption{
+				{"Línea", "", nil},
+				{"Sistema operativo", "", []string{"Tizen", "webOS", "Google TV"}},
+			}},
+			{"Conectividad", []specOption{
+				{"Cantidad de puertos HDMI", "", []string{"2", "3", "4"}},
+				{"Con Wi-Fi", "", []string{"Sí"}},
+			}},
+		},
+		minPrice: 250,
+		maxPrice: 3000,
+	},
+	{
+		family: "Auriculares",
+		noun:   "Auriculares",
+		brands: []brand{
+			{"Sony", []string{"WH-1000XM5", "WF-C700N", "WH-CH520"}},
+			{"JBL", []string{"Tune 520BT", "Live 660NC", "Wave Buds"}},
+			{"Apple", []string{"AirPods Pro", "AirPods 4"}},
+		},
+		main: []specOption{
+			{"Formato", "default", []string{"In-ear", "On-ear", "Over-ear"}},
+			{"Con cancelación de ruido", "noise_cancelling", []string{"Sí", "No"}},
+			{"Duración de la batería", "battery", []string{"20 h", "30 h", "50 h"}},
+		},
+		secondary: []specGroupOption{
+			{"Características generales", []specOption{
+				{"Modelo", "", nil},
+				{"Es inalámbrico", "", []string{"Sí", "No"}},
+			}},
+		},
+		minPrice: 30,
+		maxPrice: 450,
+	},
+	{
+		family: "Smartwatches",
+		noun:   "Smartwatch",
+		brands: []brand{
+			{"Samsung", []string{"Galaxy Watch7", "Galaxy Watch FE"}},
+			{"Xiaomi", []string{"Redmi Watch 4", "Smart Band 9"}},
+			{"Amazfit", []string{"GTS 4", "Bip 5", "T-Rex 3"}},
+		},
+		main: []specOption{
+			{"Tamaño de la caja", "screen_size", []string{"40 mm", "44 mm", "46 mm"}},
+			{"Con GPS", "gps", []string{"Sí", "No"}},
+			{"Resistencia al agua", "water_resistance", []string{"5 ATM", "10 ATM", "IP68"}},
+		},
+		secondary: []specGroupOption{
+			{"Características generales", []specOption{
+				{"Línea", "", nil},
+				{"Con monitor de ritmo cardíaco", "", []string{"Sí"}},
+			}},
+		},
+		minPrice: 40,
+		maxPrice: 500,
+	},
+}
+
+// currencies the items are priced in, with a rough rate per US dollar.
+var currencies = []struct {
+	id     string
+	symbol string
+	rate   float64
+}{
+	{"COP", "$", 4020},
+	{"ARS", "$", 1330},
+	{"MXN", "$", 18.65},
+	{"BRL", "R$", 5.45},
+}
+
+var paymentLogos = []struct {
+	paymentType string
+	alt         string
+	url         string
+}{
+	{"credit", "Visa logo", "https://http2.mlstatic.com/storage/logos-api-admin/a5f047d0-9be0-11ec-aad4-c3381f368aaf-m.svg"},
+	{"credit", "Mastercard logo", "https://http2.mlstatic.com/storage/logos-api-admin/b2c93a40-f3be-11eb-9984-b7076edb0bb7-m.svg"},
+	{"credit", "American Express logo", "https://http2.mlstatic.com/storage/logos-api-admin/b4785730-c13f-11ee-b4b3-bb9a23b70639-m.svg"},
+	{"debit", "Visa Débito logo", "https://http2.mlstatic.com/storage/logos-api-admin/312238e0-571b-11e8-823a-758d95db88db-m.svg"},
+	{"transfer", "PSE logo", "https://http2.mlstatic.com/storage/logos-api-admin/0b2ba2f0-a4c7-11ee-9e5f-a5a3dee8bfba-m.svg"},
+}
+
+var sellerWords = [][]string{
+	{"Tienda", "Mundo", "Centro", "Distribuidora", "Planeta", "Casa"},
+	{"Tech", "Digital", "Electro", "Gamer", "Mobile", "Hogar"},
+	{"Store", "Express", "Oficial", "Shop", "Plus", "Center"},
+}
+
+var reputations = []string{"Mercado Lider Platinum", "Mercado Lider Gold", "Mercado Lider", ""}
+
+var attentionDescriptions = []string{"Brinda buena atención", "Responde rápido", "Atención regular"}
+
+var punctualityDescriptions = []string{"Entrega sus productos a tiempo", "Despacha sus productos con demora"}
+
+var descriptions = []string{
+	"Producto nuevo en caja sellada, con garantía oficial de 12 meses.",
+	"Envío inmediato. Emitimos factura. Consultá por stock antes de comprar.",
+	"Equipo en excelente estado, probado y con todos sus accesorios.",
+}
+
+// reviewContents holds the review texts by rating, index 0 being 1 star.
+var reviewContents = [][]string{
+	{"Llegó fallado y el vendedor no responde.", "No lo recomiendo, dejó de funcionar a la semana."},
+	{"No es lo que esperaba, la calidad es baja.", "Funciona, pero la batería dura muy poco."},
+	{"Cumple, nada del otro mundo.", "Está bien por el precio."},
+	{"Muy buen producto, llegó antes de lo previsto.", "Buena relación precio calidad."},
+	{"Excelente, superó mis expectativas.", "Tal cual la descripción, lo recomiendo.", "Impecable, el vendedor muy atento."},
+}
+
+var questionTexts = []string{
+	"¿Tiene garantía oficial?",
+	"¿Hacen envíos a todo el país?",
+	"¿Es la versión internacional?",
+	"¿Viene con cargador incluido?",
+	"¿Tienen stock en otro color?",
+	"¿Emiten factura?",
+}
+
+var answerTexts = []string{
+	"¡Hola! Sí, cuenta con garantía oficial de 12 meses. Saludos.",
+	"Hola, sí, realizamos envíos a todo el país. ¡Esperamos tu compra!",
+	"Buenas tardes, sí. Quedamos atentos a cualquier otra consulta.",
+	"Hola, por el momento solo tenemos stock en los colores publicados.",
+}
@@ -0,0 +1,140 @@
+// Package seed inserts seed tables, the sample data of package seeds or a
+// generated synthetic catalog, into the database.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"meli-backend/internal/db/seeds"
+	"slices"
+	"strings"
+)
+
+// batchSize is the number of rows per INSERT statement, which keeps
+// statements of large synthetic catalogs at a reasonable size.
+const batchSize = 500
+
+// tableOrder lists the seeded tables so that each one comes after the tables
+// its foreign keys reference.
+var tableOrder = []string{
+	"images",
+	"sellers",
+	"families",
+	"prices",
+	"payment_method_groups",
+	"payment_methods",
+	"products",
+	"top_sellers",
+	"user_products",
+	"items",
+	"item_images",
+	"aggregated_reviews",
+	"questions",
+	"reviews",
+	"exchange_rates",
+}
+
+// Result is the outcome of seeding a table: how many rows were given and how
+// many of them did not exist yet.
+type Result struct {
+	Table    string
+	Rows     int
+	Inserted int
+}
+
+// Sort orders tables by their foreign key dependencies, keeping the given
+// order among tables of the same name. Tables it doesn't know are an error.
+func Sort(tables []seeds.Table) ([]seeds.Table, error) {
+	for _, table := range tables {
+		if !slices.Contains(tableOrder, table.Name) {
+			return nil, fmt.Errorf("seed table %s: unknown table", table.Name)
+		}
+	}
+	sorted := slices.Clone(tables)
+	slices.SortStableFunc(sorted, func(a, b seeds.Table) int {
+		return slices.Index(tableOrder, a.Name) - slices.Index(tableOrder, b.Name)
+	})
+	return sorted, nil
+}
+
+// Apply inserts tables in dependency order in a single transaction. Rows
+// whose key already exists are left as they are, so applying the same tables
+// twice inserts nothing the second time.
+func Apply(ctx context.Context, db *sql.DB, tables []seeds.Table) ([]Result, error) {
+	sorted, err := Sort(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := make([]Result, 0, len(sorted))
+	for _, table := range sorted {
+		result := Result{Table: table.Name, Rows: len(table.Rows)}
+		for batch := range slices.Chunk(table.Rows, batchSize) {
+			res, err := tx.ExecContext(ctx, Statement(seeds.Table{Name: table.Name, Columns: table.Columns, Rows: batch}))
+			if err != nil {
+				return nil, fmt.Errorf("seed table %s: %w", table.Name, err)
+			}
+			inserted, err := res.RowsAffected()
+			if err != nil {
+				return nil, fmt.Errorf("seed table %s: %w", table.Name, err)
+			}
+			result.Inserted += int(inserted)
+		}
+		results = append(results, result)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Statement returns the INSERT of every row of table, skipping rows that
+// conflict with existing ones. Values are written as untyped string literals,
+// which Postgres converts to the column types, enums and jsonb included.
+func Statement(table seeds.Table) string {
+	var b strings.Builder
+	b.WriteString("INSERT INTO ")
+	b.WriteString(quoteIdentifier(table.Name))
+	b.WriteString(" (")
+	for i, column := range table.Columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(quoteIdentifier(column))
+	}
+	b.WriteString(") VALUES")
+	for i, row := range table.Rows {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n\t(")
+		for j, column := range table.Columns {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(quoteLiteral(row[column]))
+		}
+		b.WriteString(")")
+	}
+	b.WriteString("\nON CONFLICT DO NOTHING")
+	return b.String()
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteLiteral(value *string) string {
+	if value == nil {
+		return "NULL"
+	}
+	return "'" + strings.ReplaceAll(*value, "'", "''") + "'"
+}
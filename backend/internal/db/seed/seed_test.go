@@ -0,0 +1,132 @@
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"meli-backend/internal/db/seeds"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func value(s string) *string {
+	return &s
+}
+
+func TestSort(t *testing.T) {
+	sorted, err := Sort([]seeds.Table{{Name: "items"}, {Name: "images"}, {Name: "prices"}, {Name: "images", Columns: []string{"id"}}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []seeds.Table{{Name: "images"}, {Name: "images", Columns: []string{"id"}}, {Name: "prices"}, {Name: "items"}}, sorted)
+}
+
+func TestSort_UnknownTable(t *testing.T) {
+	_, err := Sort([]seeds.Table{{Name: "orders"}})
+
+	assert.EqualError(t, err, "seed table orders: unknown table")
+}
+
+func TestSort_SeedFiles(t *testing.T) {
+	tables, err := seeds.Load(seeds.FS)
+	require.NoError(t, err)
+
+	sorted, err := Sort(tables)
+
+	require.NoError(t, err)
+	assert.Len(t, sorted, len(tables))
+}
+
+func TestStatement(t *testing.T) {
+	statement := Statement(seeds.Table{
+		Name:    "questions",
+		Columns: []string{"id", "question", "answer"},
+		Rows: []seeds.Row{
+			{"id": value("q-1"), "question": value("¿It's new?"), "answer": nil},
+			{"id": value("q-2"), "question": value("Colors?"), "answer": value("Black")},
+		},
+	})
+
+	assert.Equal(t, `INSERT INTO "questions" ("id", "question", "answer") VALUES
+	('q-1', '¿It''s new?', NULL),
+	('q-2', 'Colors?', 'Black')
+ON CONFLICT DO NOTHING`, statement)
+}
+
+func TestApply(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows := make([]seeds.Row, batchSize+1)
+	for i := range rows {
+		rows[i] = seeds.Row{"id": value(fmt.Sprint(i))}
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "images"`)).WillReturnResult(sqlmock.NewResult(0, batchSize))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "images"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "families"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := Apply(context.Background(), db, []seeds.Table{
+		{Name: "families", Columns: []string{"family_id"}, Rows: []seeds.Row{{"family_id": value("f-1")}}},
+		{Name: "images", Columns: []string{"id"}, Rows: rows},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []Result{
+		{Table: "images", Rows: batchSize + 1, Inserted: batchSize},
+		{Table: "families", Rows: 1, Inserted: 1},
+	}, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApply_FailureRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "images"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "families"`)).WillReturnError(errors.New("relation does not exist"))
+	mock.ExpectRollback()
+
+	_, err = Apply(context.Background(), db, []seeds.Table{
+		{Name: "images", Columns: []string{"id"}, Rows: []seeds.Row{{"id": value("i-1")}}},
+		{Name: "families", Columns: []string{"family_id"}, Rows: []seeds.Row{{"family_id": value("f-1")}}},
+	})
+
+	assert.EqualError(t, err, "seed table families: relation does not exist")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestApply_Postgres seeds the sample data and a synthetic catalog twice into
+// a migrated database given by TEST_DATABASE_URL; the second time inserts
+// nothing.
+func TestApply_Postgres(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	tables, err := seeds.Load(seeds.FS)
+	require.NoError(t, err)
+	tables = append(tables, Synthetic(50, 7)...)
+
+	_, err = Apply(context.Background(), db, tables)
+	require.NoError(t, err)
+	results, err := Apply(context.Background(), db, tables)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Zero(t, result.Inserted, result.Table)
+	}
+}
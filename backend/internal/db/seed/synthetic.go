@@ -0,0 +1,352 @@
+package seed
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"meli-backend/internal/db/seeds"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// syntheticEpoch is the time synthetic timestamps count back from, fixed so
+// the catalog doesn't depend on when it is generated.
+var syntheticEpoch = time.Date(2025, 8, 24, 8, 18, 0, 0, time.UTC)
+
+const timestampLayout = "2006-01-02 15:04:05"
+
+// syntheticNamespace scopes the UUIDs of synthetic rows.
+var syntheticNamespace = uuid.MustParse("2b0c3d47-7f0e-4d0a-9a55-6b1f4f3e8c21")
+
+var syntheticColumns = map[string][]string{
+	"images":                {"id", "url_small_version", "url_medium_version", "alt"},
+	"sellers":               {"seller_id", "name", "reputation", "number_of_products", "number_of_sales", "number_of_followers", "category_description", "general_rating", "attention_description", "puntuality_description", "image_id"},
+	"families":              {"family_id", "title"},
+	"prices":                {"id", "value", "currency_symbol", "currency_id"},
+	"payment_method_groups": {"id"},
+	"payment_methods":       {"id", "group_id", "number_of_installments", "interest_rate_percentage", "type", "image_id"},
+	"products":              {"id", "title", "model", "main_spec", "secondary_spec", "family_id", "payment_group_id"},
+	"user_products":         {"id", "product_id", "seller_id", "sku"},
+	"items":                 {"item_id", "user_product_id", "title", "description", "available_quantity", "product_status", "price_id_fk", "created_at"},
+	"item_images":           {"id", "item_id", "image_id"},
+	"aggregated_reviews":    {"id", "product_id", "rating_value", "rating_count"},
+	"questions":             {"id", "item_id", "question", "answer", "created_at", "answered_at"},
+	"reviews":               {"id", "product_id", "seller_id", "item_id", "rating", "content", "created_at"},
+}
+
+type mainSpec struct {
+	Item         string `json:"item"`
+	Value        string `json:"value"`
+	ImageIconURL string `json:"image_icon_url"`
+}
+
+type secondarySpecGroup struct {
+	Item   string               `json:"item"`
+	Values []secondarySpecValue `json:"values"`
+}
+
+type secondarySpecValue struct {
+	Item  string `json:"item"`
+	Value string `json:"value"`
+}
+
+// Synthetic generates a catalog of items listings with their sellers,
+// products, families, images, payment groups, reviews and questions, for
+// load tests and demos. It is deterministic: the same items and randomSeed
+// always give the same rows, IDs included, so seeding it twice is a no-op.
+func Synthetic(items int, randomSeed uint64) []seeds.Table {
+	g := &generator{
+		rng:    rand.New(rand.NewPCG(randomSeed, 0)),
+		seed:   randomSeed,
+		tables: map[string]*seeds.Table{},
+	}
+	g.generate(items)
+
+	tables := make([]seeds.Table, 0, len(g.tables))
+	for _, name := range tableOrder {
+		if table, ok := g.tables[name]; ok {
+			tables = append(tables, *table)
+		}
+	}
+	return tables
+}
+
+type generator struct {
+	rng    *rand.Rand
+	seed   uint64
+	tables map[string]*seeds.Table
+}
+
+type syntheticProduct struct {
+	id       string
+	title    string
+	category category
+	// price in US dollars
+	price float64
+	// ratings of the reviews of every item of the product
+	ratings []int
+}
+
+func (g *generator) generate(items int) {
+	if items <= 0 {
+		return
+	}
+
+	families := map[string]string{}
+	for _, category := range categories {
+		families[category.family] = g.id("family", len(families))
+		g.add("families", families[category.family], category.family)
+	}
+
+	logos := make([]string, len(paymentLogos))
+	for i, logo := range paymentLogos {
+		logos[i] = g.id("logo", i)
+		g.add("images", logos[i], logo.url, logo.url, logo.alt)
+	}
+
+	sellers := make([]string, max(1, items/10))
+	for i := range sellers {
+		sellers[i] = g.id("seller", i)
+		name := g.pick(sellerWords[0]) + " " + g.pick(sellerWords[1]) + " " + g.pick(sellerWords[2])
+		imageID := g.image("seller", i, name+" logo")
+		g.add("sellers", sellers[i], name, g.pick(reputations),
+			g.rng.IntN(2000), g.rng.IntN(50000), g.rng.IntN(10000),
+			"Category Description", g.decimal(3+2*g.rng.Float64()),
+			g.pick(attentionDescriptions), g.pick(punctualityDescriptions), imageID)
+	}
+
+	products := make([]*syntheticProduct, max(1, items/4))
+	for i := range products {
+		products[i] = g.product(i, families, logos)
+	}
+
+	for i := range items {
+		g.item(i, products[g.rng.IntN(len(products))], sellers[g.rng.IntN(len(sellers))])
+	}
+
+	// products nobody reviewed are left without aggregated review, as
+	// products are until their first review
+	for i, product := range products {
+		if len(product.ratings) == 0 {
+			continue
+		}
+		sum := 0
+		for _, rating := range product.ratings {
+			sum += rating
+		}
+		g.add("aggregated_reviews", g.id("aggregated-review", i), product.id,
+			g.decimal(float64(sum)/float64(len(product.ratings))), len(product.ratings))
+	}
+}
+
+func (g *generator) product(i int, families map[string]string, logos []string) *syntheticProduct {
+	category := categories[g.rng.IntN(len(categories))]
+	brand := category.brands[g.rng.IntN(len(category.brands))]
+	line := g.pick(brand.lines)
+	color := g.pick(colors)
+
+	main := make([]mainSpec, 0, len(category.main)+1)
+	for _, option := range category.main {
+		main = append(main, mainSpec{Item: option.item, Value: g.pick(option.values), ImageIconURL: iconBaseURL + option.icon + ".svg"})
+	}
+	main = append(main, mainSpec{Item: "Color", Value: color, ImageIconURL: iconBaseURL + "default.svg"})
+
+	secondary := make([]secondarySpecGroup, 0, len(category.secondary))
+	for j, option := range category.secondary {
+		group := secondarySpecGroup{Item: option.title}
+		if j == 0 {
+			group.Values = append(group.Values, secondarySpecValue{Item: "Marca", Value: brand.name})
+		}
+		for _, spec := range option.specs {
+			value := line
+			if spec.values != nil {
+				value = g.pick(spec.values)
+			}
+			group.Values = append(group.Values, secondarySpecValue{Item: spec.item, Value: value})
+		}
+		secondary = append(secondary, group)
+	}
+
+	product := &syntheticProduct{
+		id:       g.id("product", i),
+		title:    strings.Join([]string{category.noun, brand.name, line, main[0].Value, color}, " "),
+		category: category,
+		price:    category.minPrice + (category.maxPrice-category.minPrice)*g.rng.Float64(),
+	}
+
+	// one payment group per product, as payment_group_id is unique
+	groupID := g.id("payment-group", i)
+	g.add("payment_method_groups", groupID)
+	for j, logo := range paymentLogos {
+		if j > 0 && g.rng.IntN(3) == 0 {
+			continue
+		}
+		installments, interest := 1, 0.0
+		if logo.paymentType == "credit" {
+			installments = []int{1, 3, 6, 12, 24}[g.rng.IntN(5)]
+			if installments > 6 {
+				interest = 10 + 30*g.rng.Float64()
+			}
+		}
+		// some methods have no logo, which clients must cope with
+		var imageID *string
+		if g.rng.IntN(10) > 0 {
+			imageID = &logos[j]
+		}
+		g.add("payment_methods", g.id(fmt.Sprintf("payment-method-%d", i), j), groupID,
+			installments, g.decimal(interest), logo.paymentType, imageID)
+	}
+
+	model := strings.ToUpper(strings.ReplaceAll(brand.name[:2]+"-"+line, " ", ""))
+	g.add("products", product.id, product.title, model, g.json(main), g.json(secondary), families[category.family], groupID)
+	return product
+}
+
+func (g *generator) item(i int, product *syntheticProduct, sellerID string) {
+	itemID := g.id("item", i)
+	createdAt := g.before(syntheticEpoch, 180*24*time.Hour)
+
+	userProductID := g.id("user-product", i)
+	g.add("user_products", userProductID, product.id, sellerID, fmt.Sprintf("SKU%08d", g.rng.IntN(100000000)))
+
+	currency := currencies[g.rng.IntN(len(currencies))]
+	// listings of a product are priced within 15% of each other
+	price := product.price * currency.rate * (0.85 + 0.3*g.rng.Float64())
+	priceID := g.id("price", i)
+	g.add("prices", priceID, g.decimal(math.Round(price)), currency.symbol, currency.id)
+
+	status := "New"
+	switch n := g.rng.IntN(10); {
+	case n == 0:
+		status = "Used"
+	case n == 1:
+		status = "Acondicionado"
+	}
+	var description *string
+	if g.rng.IntN(4) > 0 {
+		description = &descriptions[g.rng.IntN(len(descriptions))]
+	}
+	g.add("items", itemID, userProductID, product.title, description, g.rng.IntN(50), status, priceID, createdAt.Format(timestampLayout))
+
+	for j := range 1 + g.rng.IntN(4) {
+		imageID := g.image(fmt.Sprintf("item-%d", i), j, fmt.Sprintf("%s foto %d", product.title, j+1))
+		g.add("item_images", g.id(fmt.Sprintf("item-image-%d", i), j), itemID, imageID)
+	}
+
+	// most listings have a few reviews, some many
+	reviews := g.rng.IntN(4)
+	if g.rng.IntN(5) == 0 {
+		reviews += g.rng.IntN(20)
+	}
+	for j := range reviews {
+		rating := g.rating()
+		product.ratings = append(product.ratings, rating)
+		g.add("reviews", g.id(fmt.Sprintf("review-%d", i), j), product.id, sellerID, itemID,
+			rating, g.pick(reviewContents[rating-1]), g.between(createdAt, syntheticEpoch).Format(timestampLayout))
+	}
+
+	for j := range g.rng.IntN(5) {
+		askedAt := g.between(createdAt, syntheticEpoch)
+		var answer, answeredAt *string
+		if g.rng.IntN(10) < 7 {
+			text := g.pick(answerTexts)
+			at := g.between(askedAt, syntheticEpoch).Format(timestampLayout)
+			answer, answeredAt = &text, &at
+		}
+		g.add("questions", g.id(fmt.Sprintf("question-%d", i), j), itemID, g.pick(questionTexts),
+			answer, askedAt.Format(timestampLayout), answeredAt)
+	}
+}
+
+// image adds an image and returns its ID. URLs point at a placeholder
+// service, keyed so the same image is returned for the same row.
+func (g *generator) image(kind string, i int, alt string) string {
+	id := g.id("image-"+kind, i)
+	url := "https://picsum.photos/seed/" + id
+	g.add("images", id, url+"/200/200", url+"/500/500", alt)
+	return id
+}
+
+// rating returns a review rating, skewed towards the high end as store
+// reviews are.
+func (g *generator) rating() int {
+	switch n := g.rng.IntN(100); {
+	case n < 5:
+		return 1
+	case n < 10:
+		return 2
+	case n < 20:
+		return 3
+	case n < 50:
+		return 4
+	}
+	return 5
+}
+
+// id returns the UUID of the i-th row of kind, derived from the random seed
+// so catalogs of different seeds don't collide.
+func (g *generator) id(kind string, i int) string {
+	return uuid.NewSHA1(syntheticNamespace, fmt.Appendf(nil, "%d/%s/%d", g.seed, kind, i)).String()
+}
+
+func (g *generator) pick(values []string) string {
+	return values[g.rng.IntN(len(values))]
+}
+
+// before returns a time within window before t, to the second.
+func (g *generator) before(t time.Time, window time.Duration) time.Time {
+	return t.Add(-time.Duration(g.rng.Int64N(int64(window/time.Second))) * time.Second)
+}
+
+// between returns a time between from and to, to the second.
+func (g *generator) between(from, to time.Time) time.Time {
+	seconds := int64(to.Sub(from) / time.Second)
+	if seconds <= 0 {
+		return from
+	}
+	return from.Add(time.Duration(g.rng.Int64N(seconds)) * time.Second)
+}
+
+func (g *generator) decimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+func (g *generator) json(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		// only plain structs are encoded
+		panic(err)
+	}
+	return string(encoded)
+}
+
+// add appends a row to table, values being given in the order of its
+// columns: strings, *string (nil is NULL) or ints.
+func (g *generator) add(table string, values ...interface{}) {
+	columns := syntheticColumns[table]
+	t, ok := g.tables[table]
+	if !ok {
+		t = &seeds.Table{Name: table, Columns: columns}
+		g.tables[table] = t
+	}
+
+	row := make(seeds.Row, len(columns))
+	for i, column := range columns {
+		switch value := values[i].(type) {
+		case string:
+			row[column] = &value
+		case *string:
+			row[column] = value
+		case int:
+			formatted := strconv.Itoa(value)
+			row[column] = &formatted
+		default:
+			panic(fmt.Sprintf("synthetic %s.%s: unsupported value %T", table, column, value))
+		}
+	}
+	t.Rows = append(t.Rows, row)
+}
@@ -0,0 +1,116 @@
+package seed
+
+import (
+	"encoding/json"
+	"meli-backend/internal/db/seeds"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tablesByName(tables []seeds.Table) map[string]seeds.Table {
+	byName := map[string]seeds.Table{}
+	for _, table := range tables {
+		byName[table.Name] = table
+	}
+	return byName
+}
+
+func keys(table seeds.Table, column string) map[string]bool {
+	keys := map[string]bool{}
+	for _, row := range table.Rows {
+		keys[row.String(column)] = true
+	}
+	return keys
+}
+
+func TestSynthetic_Deterministic(t *testing.T) {
+	assert.Equal(t, Synthetic(40, 1), Synthetic(40, 1))
+	assert.NotEqual(t, Synthetic(40, 1), Synthetic(40, 2))
+	assert.Empty(t, Synthetic(0, 1))
+}
+
+func TestSynthetic_Catalog(t *testing.T) {
+	tables := Synthetic(200, 42)
+	byName := tablesByName(tables)
+
+	sorted, err := Sort(tables)
+	require.NoError(t, err)
+	assert.Equal(t, tables, sorted, "tables come in dependency order")
+
+	assert.Len(t, byName["items"].Rows, 200)
+	assert.Len(t, byName["products"].Rows, 50)
+	assert.Len(t, byName["sellers"].Rows, 20)
+	assert.Len(t, byName["families"].Rows, len(categories))
+	assert.NotEmpty(t, byName["reviews"].Rows)
+	assert.NotEmpty(t, byName["questions"].Rows)
+
+	// every foreign key references a generated row
+	references := []struct{ table, column, target, key string }{
+		{"sellers", "image_id", "images", "id"},
+		{"payment_methods", "group_id", "payment_method_groups", "id"},
+		{"payment_methods", "image_id", "images", "id"},
+		{"products", "family_id", "families", "family_id"},
+		{"products", "payment_group_id", "payment_method_groups", "id"},
+		{"user_products", "product_id", "products", "id"},
+		{"user_products", "seller_id", "sellers", "seller_id"},
+		{"items", "user_product_id", "user_products", "id"},
+		{"items", "price_id_fk", "prices", "id"},
+		{"item_images", "item_id", "items", "item_id"},
+		{"item_images", "image_id", "images", "id"},
+		{"aggregated_reviews", "product_id", "products", "id"},
+		{"questions", "item_id", "items", "item_id"},
+		{"reviews", "product_id", "products", "id"},
+		{"reviews", "seller_id", "sellers", "seller_id"},
+		{"reviews", "item_id", "items", "item_id"},
+	}
+	for _, ref := range references {
+		targets := keys(byName[ref.target], ref.key)
+		for _, row := range byName[ref.table].Rows {
+			if value := row[ref.column]; value != nil {
+				assert.True(t, targets[*value], "%s.%s %s", ref.table, ref.column, *value)
+			}
+		}
+	}
+
+	// primary keys are unique
+	for _, table := range tables {
+		key := syntheticColumns[table.Name][0]
+		assert.Len(t, keys(table, key), len(table.Rows), table.Name)
+	}
+}
+
+func TestSynthetic_Values(t *testing.T) {
+	byName := tablesByName(Synthetic(100, 3))
+
+	for _, product := range byName["products"].Rows {
+		var main []mainSpec
+		require.NoError(t, json.Unmarshal([]byte(product.String("main_spec")), &main))
+		assert.NotEmpty(t, main)
+		var secondary []secondarySpecGroup
+		require.NoError(t, json.Unmarshal([]byte(product.String("secondary_spec")), &secondary))
+		assert.Equal(t, "Marca", secondary[0].Values[0].Item)
+	}
+
+	ratings := map[string][]int{}
+	for _, review := range byName["reviews"].Rows {
+		rating, err := strconv.Atoi(review.String("rating"))
+		require.NoError(t, err)
+		assert.True(t, rating >= 1 && rating <= 5)
+		ratings[review.String("product_id")] = append(ratings[review.String("product_id")], rating)
+	}
+	assert.Len(t, byName["aggregated_reviews"].Rows, len(ratings))
+	for _, aggregated := range byName["aggregated_reviews"].Rows {
+		productRatings := ratings[aggregated.String("product_id")]
+		assert.Equal(t, strconv.Itoa(len(productRatings)), aggregated.String("rating_count"))
+	}
+
+	for _, question := range byName["questions"].Rows {
+		assert.Equal(t, question["answer"] == nil, question["answered_at"] == nil)
+		if question["answered_at"] != nil {
+			assert.GreaterOrEqual(t, question.String("answered_at"), question.String("created_at"))
+		}
+	}
+}
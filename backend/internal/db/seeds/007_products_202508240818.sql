@@ -1,2 +1,2 @@
 INSERT INTO public.products (id,title,model,main_spec,secondary_spec,family_id,payment_group_id) VALUES
-	 ('7d32b232-5a0c-43c4-8f48-34c98533d6a1'::uuid,'Celular Samsung Galaxy S24+ 5g 256gb Light Pink','SM-S926BZVJLT','[{"item": "Memoria interna", "vale": "256 GB", "image_icon_url": "https://http2.mlstatic.com/storage/catalog-technical-specs/images/assets/vectorial/internal_memory.svg"}, {"item": "Color", "vale": "Cobalt violet", "image_icon_url": "https://http2.mlstatic.com/storage/catalog-technical-specs/images/assets/vectorial/default.svg"}]','[{"item": "Características generales", "values": [{"item": "Marca", "value": "Samsung"}]}]','d9f950ba-9031-4003-bfaa-613aa09159ae'::uuid,'a5be4f6e-f37f-41c7-bc56-22531663e455'::uuid);
+	 ('7d32b232-5a0c-43c4-8f48-34c98533d6a1'::uuid,'Celular Samsung Galaxy S24+ 5g 256gb Light Pink','SM-S926BZVJLT','[{"item": "Memoria interna", "value": "256 GB", "image_icon_url": "https://http2.mlstatic.com/storage/catalog-technical-specs/images/assets/vectorial/internal_memory.svg"}, {"item": "Color", "value": "Cobalt violet", "image_icon_url": "https://http2.mlstatic.com/storage/catalog-technical-specs/images/assets/vectorial/default.svg"}]','[{"item": "Características generales", "values": [{"item": "Marca", "value": "Samsung"}]}]','d9f950ba-9031-4003-bfaa-613aa09159ae'::uuid,'a5be4f6e-f37f-41c7-bc56-22531663e455'::uuid);
@@ -0,0 +1,8 @@
+INSERT INTO public.exchange_rates (base_currency,quote_currency,rate,updated_at) VALUES
+	 ('USD','COP',4020.0000000000,'2025-08-24 08:18:00'),
+	 ('USD','ARS',1330.0000000000,'2025-08-24 08:18:00'),
+	 ('USD','BRL',5.4500000000,'2025-08-24 08:18:00'),
+	 ('USD','MXN',18.6500000000,'2025-08-24 08:18:00'),
+	 ('USD','CLP',965.0000000000,'2025-08-24 08:18:00'),
+	 ('USD','UYU',40.1000000000,'2025-08-24 08:18:00'),
+	 ('USD','EUR',0.8550000000,'2025-08-24 08:18:00');
@@ -0,0 +1,260 @@
+// Package seeds embeds the sample data loaded by `meli-backend seed` and parses
+// its INSERT statements, so it can be loaded without a database.
+package seeds
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// Table holds the rows of the INSERT statement of a seed file.
+type Table struct {
+	Name    string
+	Columns []string
+	Rows    []Row
+}
+
+// Row maps column names to values as written in the statement, without
+// quotes nor casts. NULL values are nil.
+type Row map[string]*string
+
+// String returns the value of column, or "" when it is NULL.
+func (r Row) String(column string) string {
+	if value := r[column]; value != nil {
+		return *value
+	}
+	return ""
+}
+
+// Load parses every seed file of fsys, in file name order.
+func Load(fsys fs.FS) ([]Table, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	tables := make([]Table, 0, len(files))
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		table, err := Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("seed %s: %w", file, err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// Parse parses a single `INSERT INTO table (columns) VALUES (...), (...);`
+// statement, the format the seed files are exported in. String literals,
+// numbers, NULL and `::type` casts are supported.
+func Parse(statement string) (Table, error) {
+	p := &parser{input: strings.TrimSpace(statement)}
+
+	if !p.keyword("INSERT") || !p.keyword("INTO") {
+		return Table{}, p.errorf("expected INSERT INTO")
+	}
+	name := p.identifier()
+	if name == "" {
+		return Table{}, p.errorf("expected a table name")
+	}
+	if _, unqualified, found := strings.Cut(name, "."); found {
+		name = unqualified
+	}
+
+	table := Table{Name: name}
+	if !p.consume('(') {
+		return Table{}, p.errorf("expected the column list")
+	}
+	for {
+		column := p.identifier()
+		if column == "" {
+			return Table{}, p.errorf("expected a column name")
+		}
+		table.Columns = append(table.Columns, column)
+		if p.consume(')') {
+			break
+		}
+		if !p.consume(',') {
+			return Table{}, p.errorf("expected , or )")
+		}
+	}
+
+	if !p.keyword("VALUES") {
+		return Table{}, p.errorf("expected VALUES")
+	}
+	for {
+		values, err := p.tuple()
+		if err != nil {
+			return Table{}, err
+		}
+		if len(values) != len(table.Columns) {
+			return Table{}, p.errorf("row %d has %d values for %d columns", len(table.Rows)+1, len(values), len(table.Columns))
+		}
+		row := make(Row, len(values))
+		for i, value := range values {
+			row[table.Columns[i]] = value
+		}
+		table.Rows = append(table.Rows, row)
+
+		if p.consume(',') {
+			continue
+		}
+		p.consume(';')
+		if !p.done() {
+			return Table{}, p.errorf("unexpected content after the statement")
+		}
+		return table, nil
+	}
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) done() bool {
+	p.skipSpace()
+	return p.pos == len(p.input)
+}
+
+func (p *parser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) keyword(word string) bool {
+	p.skipSpace()
+	end := p.pos + len(word)
+	if end > len(p.input) || !strings.EqualFold(p.input[p.pos:end], word) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// identifier reads a possibly qualified and quoted name, e.g. public."type",
+// and returns it without quotes.
+func (p *parser) identifier() string {
+	p.skipSpace()
+	var name strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch {
+		case c == '"':
+			end := strings.IndexByte(p.input[p.pos+1:], '"')
+			if end < 0 {
+				return ""
+			}
+			name.WriteString(p.input[p.pos+1 : p.pos+1+end])
+			p.pos += end + 2
+		case c == '.' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9':
+			name.WriteByte(c)
+			p.pos++
+		default:
+			return name.String()
+		}
+	}
+	return name.String()
+}
+
+func (p *parser) tuple() ([]*string, error) {
+	if !p.consume('(') {
+		return nil, p.errorf("expected (")
+	}
+	var values []*string
+	for {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if p.consume(')') {
+			return values, nil
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("expected , or )")
+		}
+	}
+}
+
+func (p *parser) value() (*string, error) {
+	p.skipSpace()
+	if p.pos == len(p.input) {
+		return nil, p.errorf("expected a value")
+	}
+
+	var value *string
+	if p.input[p.pos] == '\'' {
+		literal, err := p.stringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		value = &literal
+	} else {
+		start := p.pos
+		for p.pos < len(p.input) && !strings.ContainsRune(",)': \t\r\n", rune(p.input[p.pos])) {
+			p.pos++
+		}
+		word := p.input[start:p.pos]
+		if word == "" {
+			return nil, p.errorf("expected a value")
+		}
+		if !strings.EqualFold(word, "NULL") {
+			value = &word
+		}
+	}
+
+	// casts such as '...'::uuid or 'New'::public."product_status_enum"
+	for strings.HasPrefix(p.input[p.pos:], "::") {
+		p.pos += 2
+		if p.identifier() == "" {
+			return nil, p.errorf("expected a type after ::")
+		}
+	}
+	return value, nil
+}
+
+func (p *parser) stringLiteral() (string, error) {
+	var literal strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		c := p.input[p.pos]
+		if c != '\'' {
+			literal.WriteByte(c)
+			continue
+		}
+		// a doubled quote is an escaped quote
+		if p.pos+1 < len(p.input) && p.input[p.pos+1] == '\'' {
+			literal.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		p.pos++
+		return literal.String(), nil
+	}
+	return "", p.errorf("unterminated string")
+}
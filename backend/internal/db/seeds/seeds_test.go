@@ -0,0 +1,61 @@
+package seeds
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	table, err := Parse(`INSERT INTO public.items (item_id,"name",description,quantity,status) VALUES
+	 ('55747713-9cd4-45f7-a4cd-9916ed17a61d'::uuid,'It''s 6,7"',NULL,3,'New'::public."product_status_enum"),
+	 ('6b9c1f0e-0000-4000-8000-000000000000'::uuid,'(x)','',0.50,'Used');`)
+
+	require.NoError(t, err)
+	assert.Equal(t, "items", table.Name)
+	assert.Equal(t, []string{"item_id", "name", "description", "quantity", "status"}, table.Columns)
+	require.Len(t, table.Rows, 2)
+
+	first := table.Rows[0]
+	assert.Equal(t, "55747713-9cd4-45f7-a4cd-9916ed17a61d", first.String("item_id"))
+	assert.Equal(t, `It's 6,7"`, first.String("name"))
+	assert.Nil(t, first["description"])
+	assert.Equal(t, "3", first.String("quantity"))
+	assert.Equal(t, "New", first.String("status"))
+
+	second := table.Rows[1]
+	assert.Equal(t, "(x)", second.String("name"))
+	assert.Equal(t, lo.ToPtr(""), second["description"])
+	assert.Equal(t, "0.50", second.String("quantity"))
+}
+
+func TestParse_Errors(t *testing.T) {
+	for name, statement := range map[string]string{
+		"not an insert":       "DELETE FROM items;",
+		"missing values":      "INSERT INTO items (id)",
+		"column count":        "INSERT INTO items (id,title) VALUES ('1');",
+		"unterminated string": "INSERT INTO items (id) VALUES ('1);",
+		"trailing content":    "INSERT INTO items (id) VALUES ('1'); DELETE FROM items;",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(statement)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tables, err := Load(FS)
+
+	require.NoError(t, err)
+	names := lo.Map(tables, func(table Table, _ int) string { return table.Name })
+	assert.Equal(t, []string{
+		"images", "sellers", "families", "prices", "payment_method_groups", "payment_methods", "products",
+		"user_products", "items", "item_images", "aggregated_reviews", "questions", "reviews", "exchange_rates",
+	}, names)
+	for _, table := range tables {
+		assert.NotEmpty(t, table.Rows, table.Name)
+	}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import "errors"
+
+// Error kinds. Every domain error matches exactly one of them with errors.Is,
+// which is what the HTTP layer maps to status codes.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrConflict        = errors.New("conflict")
+	ErrUnavailable     = errors.New("unavailable")
+)
+
+var ErrInvalidCursor = NewInvalidArgument("invalid cursor")
+
+var ErrUnsupportedCurrency = NewInvalidArgument("unsupported currency")
+
+// Error is a domain error of a given kind. Message describes the problem in
+// terms safe to return to clients, while Err keeps the underlying cause, e.g.
+// a database error, for logs.
+type Error struct {
+	Kind    error
+	Message string
+	Err     error
+}
+
+func NewNotFound(message string) error {
+	return &Error{Kind: ErrNotFound, Message: message}
+}
+
+func NewInvalidArgument(message string) error {
+	return &Error{Kind: ErrInvalidArgument, Message: message}
+}
+
+func NewConflict(message string) error {
+	return &Error{Kind: ErrConflict, Message: message}
+}
+
+func NewUnavailable(message string, err error) error {
+	return &Error{Kind: ErrUnavailable, Message: message, Err: err}
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = e.Kind.Error()
+	}
+	if e.Err != nil {
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+// Is makes errors.Is(err, ErrNotFound) and the like match on the kind.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorMessage returns the client-safe message of err, or an empty string
+// when err is not a domain error.
+func ErrorMessage(err error) string {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		if domainErr.Message != "" {
+			return domainErr.Message
+		}
+		return domainErr.Kind.Error()
+	}
+	for _, kind := range []error{ErrNotFound, ErrInvalidArgument, ErrConflict, ErrUnavailable} {
+		if errors.Is(err, kind) {
+			return kind.Error()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_MatchesItsKind(t *testing.T) {
+	err := NewNotFound("item not found")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrInvalidArgument)
+	assert.Equal(t, "item not found", err.Error())
+}
+
+func TestError_WrapsCause(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := fmt.Errorf("loading item: %w", NewUnavailable("database unavailable", cause))
+
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "loading item: database unavailable: connection refused", err.Error())
+}
+
+func TestError_SentinelsKeepTheirIdentity(t *testing.T) {
+	err := fmt.Errorf("page: %w", ErrInvalidCursor)
+
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.NotErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestErrorMessage(t *testing.T) {
+	assert.Equal(t, "question is already answered", ErrorMessage(NewConflict("question is already answered")))
+	assert.Equal(t, "unavailable", ErrorMessage(&Error{Kind: ErrUnavailable}))
+	assert.Equal(t, "not found", ErrorMessage(fmt.Errorf("family: %w", ErrNotFound)))
+	assert.Equal(t, "", ErrorMessage(errors.New("boom")))
+}
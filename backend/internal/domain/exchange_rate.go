@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate converts one unit of BaseCurrency into Rate units of QuoteCurrency.
+type ExchangeRate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          decimal.Decimal
+	UpdatedAt     time.Time
+}
+
+// PivotCurrency is the currency stored rates are usually quoted against. Pairs
+// without a stored rate are converted through it.
+const PivotCurrency = "USD"
+
+// exchangeRatePrecision is the number of decimal places kept when a rate is inverted.
+const exchangeRatePrecision = 10
+
+// Invert returns the rate converting QuoteCurrency into BaseCurrency.
+func (r ExchangeRate) Invert() ExchangeRate {
+	return ExchangeRate{
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          decimal.NewFromInt(1).DivRound(r.Rate, exchangeRatePrecision),
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// Compose chains this rate with one starting at its QuoteCurrency. The result
+// is as old as the oldest of both rates.
+func (r ExchangeRate) Compose(next ExchangeRate) ExchangeRate {
+	updatedAt := r.UpdatedAt
+	if next.UpdatedAt.Before(updatedAt) {
+		updatedAt = next.UpdatedAt
+	}
+
+	return ExchangeRate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: next.QuoteCurrency,
+		Rate:          r.Rate.Mul(next.Rate).Round(exchangeRatePrecision),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// Convert applies the rate to a price in BaseCurrency. The amount is rounded to
+// MoneyDecimalPlaces only once, after the multiplication.
+func (r ExchangeRate) Convert(price Price) Price {
+	return Price{
+		ID:             price.ID,
+		Amount:         NewMoney(price.Amount.Amount.Mul(r.Rate), r.QuoteCurrency).Round(),
+		CurrencySymbol: CurrencySymbol(r.QuoteCurrency),
+	}
+}
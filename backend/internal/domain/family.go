@@ -4,3 +4,29 @@ type Family struct {
 	ID    string
 	Title string
 }
+
+// FamilyVariant is one sibling item of a family, together with the values of
+// the main spec attributes that tell it apart from its siblings.
+type FamilyVariant struct {
+	Item       ItemSummary
+	ProductID  string
+	MainSpec   []MainSpecItem
+	Attributes []VariantAttribute
+}
+
+type VariantAttribute struct {
+	Name  string
+	Value string
+}
+
+// VariantOption lists every value a distinguishing attribute takes in a family.
+type VariantOption struct {
+	Name   string
+	Values []string
+}
+
+type FamilyItems struct {
+	Family   Family
+	Options  []VariantOption
+	Variants []FamilyVariant
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type HealthStatus string
+
+const (
+	HealthStatusUp   HealthStatus = "up"
+	HealthStatusDown HealthStatus = "down"
+)
+
+// DependencyHealth is the result of checking one dependency. Details holds
+// check specific values, such as the pool usage or the schema version.
+type DependencyHealth struct {
+	Name    string
+	Status  HealthStatus
+	Latency time.Duration
+	Error   string
+	Details map[string]interface{}
+}
+
+// HealthReport is up only when every dependency is.
+type HealthReport struct {
+	Status       HealthStatus
+	Dependencies []DependencyHealth
+}
+
+// PoolStats is the usage of the database connection pool.
+type PoolStats struct {
+	MaxOpenConnections int
+	OpenConnections    int
+	InUse              int
+	Idle               int
+	WaitCount          int64
+	WaitDuration       time.Duration
+}
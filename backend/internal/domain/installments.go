@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// InstallmentPlan is the cost of paying a price in a number of installments
+// with a given payment method.
+type InstallmentPlan struct {
+	PaymentMethodID   string
+	PaymentType       string
+	Installments      int
+	InstallmentAmount Money
+	// LastInstallmentAmount is InstallmentAmount plus the cents left over
+	// when an interest-free price does not divide evenly.
+	LastInstallmentAmount  Money
+	TotalAmount            Money
+	InterestRatePercentage float64
+	InterestFree           bool
+}
+
+type PaymentMethodInstallments struct {
+	PaymentMethod PaymentMethod
+	Plans         []InstallmentPlan
+}
+
+// ItemPaymentTerms is what an item needs to compute its installment plans.
+type ItemPaymentTerms struct {
+	ItemID       string
+	Price        Price
+	PaymentGroup PaymentGroup
+}
+
+type ItemInstallments struct {
+	ItemID         string
+	Price          Price
+	PaymentMethods []PaymentMethodInstallments
+	Best           *InstallmentPlan
+	// ExchangeRate is set when Price was converted to another currency
+	ExchangeRate *ExchangeRate
+}
+
+// NewItemInstallments computes every plan of every payment method of the item.
+func NewItemInstallments(terms ItemPaymentTerms) ItemInstallments {
+	methods := make([]PaymentMethodInstallments, 0, len(terms.PaymentGroup.PaymentMethods))
+	for _, method := range terms.PaymentGroup.PaymentMethods {
+		methods = append(methods, PaymentMethodInstallments{
+			PaymentMethod: method,
+			Plans:         CalculateInstallments(terms.Price.Amount, method),
+		})
+	}
+
+	return ItemInstallments{
+		ItemID:         terms.ItemID,
+		Price:          terms.Price,
+		PaymentMethods: methods,
+		Best:           BestInstallmentPlan(methods),
+	}
+}
+
+// CalculateInstallments returns one plan per installment count, from 1 up to
+// the method's NumberOfInstallments. InterestRatePercentage is a monthly rate
+// applied with the French amortization system, so every installment has the
+// same amount. A single installment is always charged at the list price.
+// An interest-free plan totals the list price: its installments are
+// rounded down to cents and the last one takes the remainder, so 1000 in 3
+// is 333.33, 333.33 and 333.34. With interest the installment is rounded to
+// cents and the total is that installment times the count.
+func CalculateInstallments(price Money, method PaymentMethod) []InstallmentPlan {
+	maxInstallments := method.NumberOfInstallments
+	if maxInstallments < 1 {
+		maxInstallments = 1
+	}
+	rate := decimal.NewFromFloat(method.InterestRatePercentage).Div(decimal.NewFromInt(100))
+	one := decimal.NewFromInt(1)
+
+	plans := make([]InstallmentPlan, 0, maxInstallments)
+	for n := 1; n <= maxInstallments; n++ {
+		count := decimal.NewFromInt(int64(n))
+		plan := InstallmentPlan{
+			PaymentMethodID:        method.ID,
+			PaymentType:            method.Type,
+			Installments:           n,
+			InterestRatePercentage: method.InterestRatePercentage,
+		}
+
+		if !rate.IsPositive() || n == 1 {
+			plan.InterestRatePercentage = 0
+			plan.InterestFree = true
+			plan.TotalAmount = price.Round()
+			amount := plan.TotalAmount.Amount.Div(count).Truncate(MoneyDecimalPlaces)
+			plan.InstallmentAmount = NewMoney(amount, price.Currency)
+			plan.LastInstallmentAmount = NewMoney(plan.TotalAmount.Amount.Sub(amount.Mul(count.Sub(one))), price.Currency)
+		} else {
+			// price * rate * (1+rate)^n / ((1+rate)^n - 1)
+			growth := one.Add(rate).Pow(count)
+			amount := price.Amount.Mul(rate).Mul(growth).Div(growth.Sub(one))
+			plan.InstallmentAmount = NewMoney(amount, price.Currency).Round()
+			plan.LastInstallmentAmount = plan.InstallmentAmount
+			// the buyer pays the rounded installment n times
+			plan.TotalAmount = NewMoney(plan.InstallmentAmount.Amount.Mul(count), price.Currency)
+		}
+
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// BestInstallmentPlan picks the plan to advertise: the most installments
+// without interest if any method offers more than one, otherwise the most
+// installments overall, cheapest first. It returns nil without plans.
+func BestInstallmentPlan(methods []PaymentMethodInstallments) *InstallmentPlan {
+	var plans []InstallmentPlan
+	for _, method := range methods {
+		plans = append(plans, method.Plans...)
+	}
+	if len(plans) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(plans, func(a, b int) bool {
+		freeA := plans[a].InterestFree && plans[a].Installments > 1
+		freeB := plans[b].InterestFree && plans[b].Installments > 1
+		if freeA != freeB {
+			return freeA
+		}
+		if plans[a].Installments != plans[b].Installments {
+			return plans[a].Installments > plans[b].Installments
+		}
+		return plans[a].TotalAmount.Amount.LessThan(plans[b].TotalAmount.Amount)
+	})
+
+	best := plans[0]
+	return &best
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func ars(amount string) Money {
+	return NewMoney(decimal.RequireFromString(amount), "ARS")
+}
+
+func assertMoney(t *testing.T, expected string, actual Money) {
+	t.Helper()
+	assert.Equal(t, expected, actual.Amount.StringFixed(MoneyDecimalPlaces))
+}
+
+func TestCalculateInstallments_InterestFree(t *testing.T) {
+	plans := CalculateInstallments(ars("1000"), PaymentMethod{ID: "visa", Type: "credit", NumberOfInstallments: 3})
+
+	assert.Len(t, plans, 3)
+	for i, plan := range plans {
+		assert.Equal(t, i+1, plan.Installments)
+		assert.Equal(t, "visa", plan.PaymentMethodID)
+		assert.True(t, plan.InterestFree)
+		assert.Equal(t, "ARS", plan.TotalAmount.Currency)
+	}
+	assertMoney(t, "1000.00", plans[0].TotalAmount)
+	assertMoney(t, "500.00", plans[1].InstallmentAmount)
+	assertMoney(t, "500.00", plans[1].LastInstallmentAmount)
+	assertMoney(t, "1000.00", plans[1].TotalAmount)
+}
+
+func TestCalculateInstallments_InterestFreeUnevenPrice(t *testing.T) {
+	plans := CalculateInstallments(ars("1000"), PaymentMethod{NumberOfInstallments: 3})
+
+	assertMoney(t, "333.33", plans[2].InstallmentAmount)
+	assertMoney(t, "333.34", plans[2].LastInstallmentAmount)
+	assertMoney(t, "1000.00", plans[2].TotalAmount)
+}
+
+func TestCalculateInstallments_InterestFreeRemainderNeverNegative(t *testing.T) {
+	// rounding 0.10 / 12 up to 0.01 would leave the last installment at -0.01
+	plans := CalculateInstallments(ars("0.10"), PaymentMethod{NumberOfInstallments: 12})
+
+	assertMoney(t, "0.00", plans[11].InstallmentAmount)
+	assertMoney(t, "0.10", plans[11].LastInstallmentAmount)
+	assertMoney(t, "0.10", plans[11].TotalAmount)
+}
+
+func TestCalculateInstallments_WithInterestUnevenPrice(t *testing.T) {
+	plans := CalculateInstallments(ars("1000"), PaymentMethod{NumberOfInstallments: 3, InterestRatePercentage: 5})
+
+	assertMoney(t, "367.21", plans[2].InstallmentAmount)
+	assertMoney(t, "367.21", plans[2].LastInstallmentAmount)
+	assertMoney(t, "1101.63", plans[2].TotalAmount)
+}
+
+func TestCalculateInstallments_WithInterest(t *testing.T) {
+	plans := CalculateInstallments(ars("1000"), PaymentMethod{NumberOfInstallments: 12, InterestRatePercentage: 5})
+
+	assert.Len(t, plans, 12)
+
+	single := plans[0]
+	assert.True(t, single.InterestFree)
+	assertMoney(t, "1000.00", single.InstallmentAmount)
+	assert.Equal(t, 0.0, single.InterestRatePercentage)
+
+	twelve := plans[11]
+	assert.False(t, twelve.InterestFree)
+	assert.Equal(t, 5.0, twelve.InterestRatePercentage)
+	assertMoney(t, "112.83", twelve.InstallmentAmount)
+	assertMoney(t, "1353.96", twelve.TotalAmount)
+}
+
+func TestCalculateInstallments_KeepsCents(t *testing.T) {
+	plans := CalculateInstallments(ars("999.99"), PaymentMethod{NumberOfInstallments: 1})
+
+	assertMoney(t, "999.99", plans[0].InstallmentAmount)
+}
+
+func TestCalculateInstallments_WithoutInstallments(t *testing.T) {
+	plans := CalculateInstallments(ars("500"), PaymentMethod{Type: "debit"})
+
+	assert.Len(t, plans, 1)
+	assertMoney(t, "500.00", plans[0].InstallmentAmount)
+	assert.True(t, plans[0].InterestFree)
+}
+
+func TestBestInstallmentPlan_PrefersInterestFree(t *testing.T) {
+	methods := []PaymentMethodInstallments{
+		{Plans: CalculateInstallments(ars("1200"), PaymentMethod{ID: "with-interest", NumberOfInstallments: 18, InterestRatePercentage: 3})},
+		{Plans: CalculateInstallments(ars("1200"), PaymentMethod{ID: "interest-free", NumberOfInstallments: 6})},
+	}
+
+	best := BestInstallmentPlan(methods)
+
+	assert.NotNil(t, best)
+	assert.Equal(t, "interest-free", best.PaymentMethodID)
+	assert.Equal(t, 6, best.Installments)
+	assertMoney(t, "200.00", best.InstallmentAmount)
+}
+
+func TestBestInstallmentPlan_FallsBackToMostInstallments(t *testing.T) {
+	methods := []PaymentMethodInstallments{
+		{Plans: CalculateInstallments(ars("1200"), PaymentMethod{ID: "cheap", NumberOfInstallments: 12, InterestRatePercentage: 2})},
+		{Plans: CalculateInstallments(ars("1200"), PaymentMethod{ID: "expensive", NumberOfInstallments: 12, InterestRatePercentage: 4})},
+		{Plans: CalculateInstallments(ars("1200"), PaymentMethod{ID: "debit", NumberOfInstallments: 1})},
+	}
+
+	best := BestInstallmentPlan(methods)
+
+	assert.NotNil(t, best)
+	assert.Equal(t, "cheap", best.PaymentMethodID)
+	assert.Equal(t, 12, best.Installments)
+}
+
+func TestBestInstallmentPlan_Empty(t *testing.T) {
+	assert.Nil(t, BestInstallmentPlan(nil))
+}
+
+func TestNewItemInstallments(t *testing.T) {
+	result := NewItemInstallments(ItemPaymentTerms{
+		ItemID: "item-id",
+		Price:  Price{Amount: ars("300"), CurrencySymbol: "$"},
+		PaymentGroup: PaymentGroup{PaymentMethods: []PaymentMethod{
+			{ID: "visa", NumberOfInstallments: 3},
+			{ID: "debit", NumberOfInstallments: 1},
+		}},
+	})
+
+	assert.Equal(t, "item-id", result.ItemID)
+	assert.Len(t, result.PaymentMethods, 2)
+	assert.Len(t, result.PaymentMethods[0].Plans, 3)
+	assert.Equal(t, "visa", result.Best.PaymentMethodID)
+	assertMoney(t, "100.00", result.Best.InstallmentAmount)
+}
@@ -8,7 +8,10 @@ type Item struct {
 	ProductStatus     string
 	UserProduct       UserProduct
 	Price             Price
+	// ExchangeRate is set when Price was converted to another currency
+	ExchangeRate      *ExchangeRate
 	ItemImages        []ItemImage
 	Reviews           []Review
+	ReviewsNextCursor string
 	Questions         []Question
 }
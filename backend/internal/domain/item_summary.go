@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// ItemSummary is the lightweight representation of an item used by listings.
+type ItemSummary struct {
+	ID           string
+	Title        string
+	Status       string
+	Price        Price
+	PrimaryImage Image
+	Rating       float64
+	RatingCount  int
+	CreatedAt    time.Time
+}
+
+type ItemSort string
+
+const (
+	ItemSortNewest    ItemSort = "newest"
+	ItemSortPriceAsc  ItemSort = "price_asc"
+	ItemSortPriceDesc ItemSort = "price_desc"
+	ItemSortRating    ItemSort = "rating"
+)
+
+var ItemSorts = []ItemSort{ItemSortNewest, ItemSortPriceAsc, ItemSortPriceDesc, ItemSortRating}
+
+var ProductStatuses = []string{"New", "Used", "Acondicionado"}
+
+type ItemFilter struct {
+	FamilyID string
+	Status   string
+	SellerID string
+	MinPrice *float64
+	MaxPrice *float64
+	// InStock keeps only the items with available quantity
+	InStock bool
+}
+
+type ItemListQuery struct {
+	Filter ItemFilter
+	Sort   ItemSort
+	Cursor string
+	Limit  int
+	// Currency converts every price of the page when set
+	Currency string
+}
+
+type ItemSummaryPage struct {
+	Items      []ItemSummary
+	NextCursor string
+	// ExchangeRates lists every rate applied to convert the page prices
+	ExchangeRates []ExchangeRate
+}
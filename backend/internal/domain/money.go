@@ -0,0 +1,49 @@
+package domain
+
+import "github.com/shopspring/decimal"
+
+// MoneyDecimalPlaces is the precision prices are stored and displayed with.
+const MoneyDecimalPlaces = 2
+
+// Money is an exact amount in an ISO 4217 currency. Amounts are never stored
+// as floats so cents are not lost along the way.
+type Money struct {
+	Amount   decimal.Decimal
+	Currency string
+}
+
+func NewMoney(amount decimal.Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Round rounds the amount half away from zero to MoneyDecimalPlaces.
+func (m Money) Round() Money {
+	return Money{Amount: m.Amount.Round(MoneyDecimalPlaces), Currency: m.Currency}
+}
+
+// String formats the amount with MoneyDecimalPlaces, e.g. "1234.50 ARS".
+func (m Money) String() string {
+	return m.Amount.StringFixed(MoneyDecimalPlaces) + " " + m.Currency
+}
+
+// currencySymbols are used when a price is converted to a currency other than
+// the one it was stored with, which is the only time the symbol is unknown.
+var currencySymbols = map[string]string{
+	"ARS": "$",
+	"BRL": "R$",
+	"CLP": "$",
+	"COP": "$",
+	"EUR": "€",
+	"MXN": "$",
+	"USD": "US$",
+	"UYU": "$U",
+}
+
+// CurrencySymbol returns the display symbol of a currency, or the currency
+// code itself when it is not known.
+func CurrencySymbol(currency string) string {
+	if symbol, ok := currencySymbols[currency]; ok {
+		return symbol
+	}
+	return currency
+}
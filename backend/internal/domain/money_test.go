@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Round(t *testing.T) {
+	money := NewMoney(decimal.RequireFromString("10.005"), "ARS").Round()
+
+	assert.Equal(t, "10.01", money.Amount.String())
+	assert.Equal(t, "ARS", money.Currency)
+}
+
+func TestMoney_String(t *testing.T) {
+	money := NewMoney(decimal.RequireFromString("1234.5"), "COP")
+
+	assert.Equal(t, "1234.50 COP", money.String())
+}
+
+func TestMoney_IsExact(t *testing.T) {
+	a := NewMoney(decimal.RequireFromString("0.1"), "USD")
+	b := NewMoney(decimal.RequireFromString("0.2"), "USD")
+
+	assert.Equal(t, "0.3", a.Amount.Add(b.Amount).String())
+}
+
+func TestCurrencySymbol(t *testing.T) {
+	assert.Equal(t, "US$", CurrencySymbol("USD"))
+	assert.Equal(t, "XYZ", CurrencySymbol("XYZ"))
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	rate := ExchangeRate{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025")}
+
+	result := rate.Convert(Price{ID: "price-id", Amount: NewMoney(decimal.RequireFromString("3137310.00"), "COP"), CurrencySymbol: "$"})
+
+	assert.Equal(t, "price-id", result.ID)
+	assert.Equal(t, "784.33", result.Amount.Amount.StringFixed(MoneyDecimalPlaces))
+	assert.Equal(t, "USD", result.Amount.Currency)
+	assert.Equal(t, "US$", result.CurrencySymbol)
+}
+
+func TestExchangeRate_Invert(t *testing.T) {
+	rate := ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "COP", Rate: decimal.RequireFromString("4000")}
+
+	inverted := rate.Invert()
+
+	assert.Equal(t, "COP", inverted.BaseCurrency)
+	assert.Equal(t, "USD", inverted.QuoteCurrency)
+	assert.Equal(t, "0.00025", inverted.Rate.String())
+}
+
+func TestExchangeRate_Compose(t *testing.T) {
+	older := time.Date(2025, 8, 23, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 8, 24, 0, 0, 0, 0, time.UTC)
+	copToUSD := ExchangeRate{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025"), UpdatedAt: newer}
+	usdToARS := ExchangeRate{BaseCurrency: "USD", QuoteCurrency: "ARS", Rate: decimal.RequireFromString("1330"), UpdatedAt: older}
+
+	composed := copToUSD.Compose(usdToARS)
+
+	assert.Equal(t, "COP", composed.BaseCurrency)
+	assert.Equal(t, "ARS", composed.QuoteCurrency)
+	assert.Equal(t, "0.3325", composed.Rate.String())
+	assert.Equal(t, older, composed.UpdatedAt)
+}
@@ -0,0 +1,7 @@
+package domain
+
+// Page sizes shared by every cursor-paginated listing.
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
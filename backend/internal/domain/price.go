@@ -2,7 +2,6 @@ package domain
 
 type Price struct {
 	ID             string
-	Value          float64
+	Amount         Money
 	CurrencySymbol string
-	CurrencyID     string
 }
@@ -10,4 +10,6 @@ type Product struct {
 	PaymentGroup     PaymentGroup
 	TopSeller        *TopSeller
 	AggregatedReview AggregatedReview
+	// RatingDistribution is computed from every review of the product
+	RatingDistribution []RatingBucket
 }
@@ -2,9 +2,37 @@ package domain
 
 import "time"
 
+const MaxQuestionLength = 2000
+
 type Question struct {
-	ID        string
-	Question  string
-	Answer    string
-	CreatedAt time.Time
+	ID         string
+	ItemID     string
+	Question   string
+	Answer     string
+	CreatedAt  time.Time
+	AnsweredAt *time.Time
+}
+
+func (q Question) IsAnswered() bool {
+	return q.Answer != ""
+}
+
+type QuestionStatus string
+
+const (
+	QuestionStatusAll        QuestionStatus = ""
+	QuestionStatusAnswered   QuestionStatus = "answered"
+	QuestionStatusUnanswered QuestionStatus = "unanswered"
+)
+
+type QuestionListQuery struct {
+	ItemID string
+	Status QuestionStatus
+	Cursor string
+	Limit  int
+}
+
+type QuestionPage struct {
+	Questions  []Question
+	NextCursor string
 }
@@ -0,0 +1,55 @@
+package domain
+
+import "sort"
+
+type RatingBucket struct {
+	Rating     int
+	Count      int
+	Percentage int
+}
+
+// NewRatingDistribution builds one bucket per star level, from MaxReviewRating
+// down to MinReviewRating, including levels without reviews. Percentages are
+// rounded with the largest remainder method so they always add up to exactly
+// 100, or are all 0 when there are no reviews.
+func NewRatingDistribution(counts map[int]int) []RatingBucket {
+	total := 0
+	for rating := MinReviewRating; rating <= MaxReviewRating; rating++ {
+		total += counts[rating]
+	}
+
+	buckets := make([]RatingBucket, 0, MaxReviewRating-MinReviewRating+1)
+	remainders := make([]int, 0, cap(buckets))
+	assigned := 0
+
+	for rating := MaxReviewRating; rating >= MinReviewRating; rating-- {
+		bucket := RatingBucket{Rating: rating, Count: counts[rating]}
+		remainder := 0
+		if total > 0 {
+			bucket.Percentage = bucket.Count * 100 / total
+			remainder = bucket.Count * 100 % total
+		}
+		assigned += bucket.Percentage
+		buckets = append(buckets, bucket)
+		remainders = append(remainders, remainder)
+	}
+
+	if total == 0 {
+		return buckets
+	}
+
+	// hand out the points lost to flooring, biggest remainder first; ties go
+	// to the higher star level, which comes first in buckets
+	order := make([]int, len(buckets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; i < 100-assigned; i++ {
+		buckets[order[i]].Percentage++
+	}
+
+	return buckets
+}
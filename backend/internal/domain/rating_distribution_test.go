@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sumPercentages(buckets []RatingBucket) int {
+	sum := 0
+	for _, bucket := range buckets {
+		sum += bucket.Percentage
+	}
+	return sum
+}
+
+func TestNewRatingDistribution_AlwaysFiveOrderedBuckets(t *testing.T) {
+	result := NewRatingDistribution(map[int]int{5: 3})
+
+	assert.Equal(t, []RatingBucket{
+		{Rating: 5, Count: 3, Percentage: 100},
+		{Rating: 4, Count: 0, Percentage: 0},
+		{Rating: 3, Count: 0, Percentage: 0},
+		{Rating: 2, Count: 0, Percentage: 0},
+		{Rating: 1, Count: 0, Percentage: 0},
+	}, result)
+}
+
+func TestNewRatingDistribution_Empty(t *testing.T) {
+	result := NewRatingDistribution(map[int]int{})
+
+	assert.Len(t, result, 5)
+	assert.Equal(t, 0, sumPercentages(result))
+}
+
+func TestNewRatingDistribution_LargestRemainder(t *testing.T) {
+	// 1/3 each: 33 + 33 + 33 leaves one point for the highest star level
+	result := NewRatingDistribution(map[int]int{5: 1, 4: 1, 3: 1})
+
+	assert.Equal(t, 34, result[0].Percentage)
+	assert.Equal(t, 33, result[1].Percentage)
+	assert.Equal(t, 33, result[2].Percentage)
+	assert.Equal(t, 100, sumPercentages(result))
+}
+
+func TestNewRatingDistribution_SumsTo100(t *testing.T) {
+	testCases := []map[int]int{
+		{5: 3, 4: 2, 3: 1, 2: 1, 1: 1},
+		{5: 7, 1: 3},
+		{5: 1, 4: 1, 3: 1, 2: 1, 1: 3},
+		{5: 997, 4: 2, 1: 1},
+	}
+
+	for _, counts := range testCases {
+		result := NewRatingDistribution(counts)
+
+		assert.Equal(t, 100, sumPercentages(result), counts)
+	}
+}
+
+func TestNewRatingDistribution_IgnoresOutOfRangeRatings(t *testing.T) {
+	result := NewRatingDistribution(map[int]int{5: 1, 0: 4, 9: 2})
+
+	assert.Equal(t, 100, result[0].Percentage)
+	assert.Equal(t, 100, sumPercentages(result))
+}
@@ -2,9 +2,66 @@ package domain
 
 import "time"
 
+const (
+	MinReviewRating        = 1
+	MaxReviewRating        = 5
+	MaxReviewContentLength = 2000
+)
+
 type Review struct {
 	ID        string
 	Rating    int
 	Content   string
 	CreatedAt time.Time
 }
+
+type NewReview struct {
+	ItemID  string
+	Rating  int
+	Content string
+}
+
+// SubmittedReview is a stored review together with the aggregate of its
+// product as it was right after the review was counted.
+type SubmittedReview struct {
+	Review           Review
+	AggregatedReview AggregatedReview
+	// ProductItemIDs are the items of the reviewed product, the reviewed one
+	// included, sorted. Their details all show the new aggregate.
+	ProductItemIDs []string
+}
+
+type ReviewSort string
+
+const (
+	ReviewSortNewest  ReviewSort = "newest"
+	ReviewSortHighest ReviewSort = "highest"
+	ReviewSortLowest  ReviewSort = "lowest"
+)
+
+var ReviewSorts = []ReviewSort{ReviewSortNewest, ReviewSortHighest, ReviewSortLowest}
+
+// ReviewScope selects whose reviews are listed: those of the item itself, or
+// those of every item (from any seller) of the same product.
+type ReviewScope string
+
+const (
+	ReviewScopeItem    ReviewScope = "item"
+	ReviewScopeProduct ReviewScope = "product"
+)
+
+var ReviewScopes = []ReviewScope{ReviewScopeItem, ReviewScopeProduct}
+
+type ReviewListQuery struct {
+	ItemID string
+	Scope  ReviewScope
+	Sort   ReviewSort
+	Rating int // 0 means every rating
+	Cursor string
+	Limit  int
+}
+
+type ReviewPage struct {
+	Reviews    []Review
+	NextCursor string
+}
@@ -0,0 +1,24 @@
+package domain
+
+const MaxSearchTextLength = 200
+
+type SearchQuery struct {
+	Text   string
+	Cursor string
+	Limit  int
+}
+
+// SearchResult is an item matched by a full-text search. TitleHighlight and
+// Snippet are HTML: the item text, escaped, with the matched terms wrapped in
+// <mark></mark>.
+type SearchResult struct {
+	Item           ItemSummary
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+type SearchPage struct {
+	Results    []SearchResult
+	NextCursor string
+}
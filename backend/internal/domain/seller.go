@@ -13,3 +13,26 @@ type Seller struct {
 	PuntualityDescription string
 	Image                 Image
 }
+
+// SellerStats are the seller counters computed from the catalog and the
+// reviews, as opposed to the ones stored in the sellers table.
+type SellerStats struct {
+	ProductsCount    int
+	ItemsCount       int
+	ActiveItemsCount int
+	ReviewsCount     int
+	AverageRating    float64
+}
+
+type SellerProfile struct {
+	Seller             Seller
+	Stats              SellerStats
+	RatingDistribution []RatingBucket
+	Items              ItemSummaryPage
+}
+
+type SellerProfileQuery struct {
+	SellerID string
+	Cursor   string
+	Limit    int
+}
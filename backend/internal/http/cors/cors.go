@@ -0,0 +1,183 @@
+// Package cors implements the CORS policy of the API: which origins may call
+// it from a browser, with which methods and headers, and whether credentials
+// are allowed.
+package cors
+
+import (
+	"errors"
+	"meli-backend/internal/http/problem"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Policy is the CORS policy of a route group.
+type Policy struct {
+	// AllowedOrigins lists exact origins ("https://example.com"), wildcard
+	// subdomains ("https://*.example.com") or "*" for any origin.
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	// ExposedHeaders are the response headers readable by the browser.
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and Authorization. It
+	// cannot be combined with "*", the matched origin is always reflected.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight response.
+	MaxAge time.Duration
+}
+
+// WithMethods returns a copy of the policy allowing methods.
+func (p Policy) WithMethods(methods ...string) Policy {
+	p.AllowedMethods = methods
+	return p
+}
+
+// WithHeaders returns a copy of the policy allowing the request headers
+// headers and exposing the response headers exposed.
+func (p Policy) WithHeaders(headers, exposed []string) Policy {
+	p.AllowedHeaders = headers
+	p.ExposedHeaders = exposed
+	return p
+}
+
+var errOriginNotAllowed = errors.New("origin not allowed")
+
+// Middleware applies the policy. Requests without an Origin header, or whose
+// Origin is the API's own scheme and host, are not cross-origin and pass
+// through untouched: browsers send Origin on same-origin POST and PUT too.
+// Requests from other origins outside the allow-list are rejected with 403,
+// and preflight requests are answered directly with 204.
+func Middleware(p Policy) gin.HandlerFunc {
+	matcher := newOriginMatcher(p.AllowedOrigins)
+	methods := strings.Join(p.AllowedMethods, ", ")
+	headers := strings.Join(p.AllowedHeaders, ", ")
+	exposed := strings.Join(p.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(p.MaxAge.Seconds()))
+	anyOrigin := matcher.any && !p.AllowCredentials
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+
+		if !anyOrigin {
+			// the response depends on the origin, caches must not mix them
+			c.Writer.Header().Add("Vary", "Origin")
+		}
+		if origin == "" || sameOrigin(c.Request, origin) {
+			c.Next()
+			return
+		}
+		if !matcher.matches(origin) {
+			problem.AbortWithStatus(c, http.StatusForbidden, errOriginNotAllowed)
+			return
+		}
+
+		if anyOrigin {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+		if p.AllowCredentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Method")
+			c.Writer.Header().Add("Vary", "Access-Control-Request-Headers")
+			c.Header("Access-Control-Allow-Methods", methods)
+			c.Header("Access-Control-Allow-Headers", headers)
+			c.Header("Access-Control-Max-Age", maxAge)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		if exposed != "" {
+			c.Header("Access-Control-Expose-Headers", exposed)
+		}
+		c.Next()
+	}
+}
+
+// sameOrigin reports whether origin is the scheme and host the request was
+// sent to. Behind a TLS-terminating proxy the scheme is X-Forwarded-Proto.
+func sameOrigin(r *http.Request, origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	} else if proto, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ","); proto != "" {
+		scheme = strings.ToLower(strings.TrimSpace(proto))
+	}
+	return strings.EqualFold(u.Scheme, scheme) && strings.EqualFold(u.Host, r.Host)
+}
+
+// originMatcher matches origins against the allow-list.
+type originMatcher struct {
+	any       bool
+	exact     map[string]bool
+	wildcards []wildcardOrigin
+}
+
+// wildcardOrigin is "scheme://*.suffix[:port]". It matches any subdomain of
+// suffix, at any depth, but not suffix itself.
+type wildcardOrigin struct {
+	scheme string
+	suffix string
+	port   string
+}
+
+func newOriginMatcher(origins []string) originMatcher {
+	m := originMatcher{exact: map[string]bool{}}
+	for _, origin := range origins {
+		origin = strings.ToLower(strings.TrimSuffix(origin, "/"))
+		switch {
+		case origin == "*":
+			m.any = true
+		case strings.Contains(origin, "://*."):
+			u, err := url.Parse(origin)
+			if err != nil {
+				continue
+			}
+			m.wildcards = append(m.wildcards, wildcardOrigin{
+				scheme: u.Scheme,
+				suffix: strings.TrimPrefix(u.Hostname(), "*"),
+				port:   u.Port(),
+			})
+		default:
+			m.exact[origin] = true
+		}
+	}
+	return m
+}
+
+func (m originMatcher) matches(origin string) bool {
+	if m.any {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	if m.exact[origin] {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	for _, w := range m.wildcards {
+		host := u.Hostname()
+		if u.Scheme == w.scheme && u.Port() == w.port &&
+			strings.HasSuffix(host, w.suffix) && len(host) > len(w.suffix) {
+			return true
+		}
+	}
+	return false
+}
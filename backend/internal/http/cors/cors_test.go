@@ -0,0 +1,198 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPolicy = Policy{
+	AllowedOrigins: []string{"https://app.example.com", "https://*.shop.example.com", "http://localhost:3000"},
+	AllowedMethods: []string{"GET", "POST"},
+	AllowedHeaders: []string{"Content-Type", "X-Request-ID"},
+	ExposedHeaders: []string{"X-Request-ID"},
+	MaxAge:         10 * time.Minute,
+}
+
+func serve(p Policy, method, origin string, header http.Header) *httptest.ResponseRecorder {
+	return serveHost(p, method, "api.test", origin, header)
+}
+
+// serveHost serves a request sent to host.
+func serveHost(p Policy, method, host, origin string, header http.Header) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(Middleware(p))
+	engine.Handle(method, "/items", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, "/items", nil)
+	req.Host = host
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddleware_AllowedOrigin(t *testing.T) {
+	w := serve(testPolicy, "GET", "https://app.example.com", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+	assert.Equal(t, "X-Request-ID", w.Header().Get("Access-Control-Expose-Headers"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+}
+
+func TestMiddleware_WildcardSubdomain(t *testing.T) {
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://ar.shop.example.com", true},
+		{"https://a.b.shop.example.com", true},
+		{"https://shop.example.com", false},
+		{"http://ar.shop.example.com", false},
+		{"https://ar.shop.example.com:8443", false},
+		{"https://evilshop.example.com", false},
+		{"https://ar.shop.example.com.evil.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			w := serve(testPolicy, "GET", tt.origin, nil)
+
+			if tt.allowed {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, tt.origin, w.Header().Get("Access-Control-Allow-Origin"))
+			} else {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
+
+func TestMiddleware_UnknownOrigin(t *testing.T) {
+	w := serve(testPolicy, "GET", "https://evil.com", nil)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "origin not allowed")
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+}
+
+func TestMiddleware_SameOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		origin  string
+		header  http.Header
+		allowed bool
+	}{
+		{"own origin", "http://api.example.com", nil, true},
+		{"own origin behind a TLS proxy", "https://api.example.com", http.Header{"X-Forwarded-Proto": {"https"}}, true},
+		{"other scheme", "https://api.example.com", nil, false},
+		{"other port", "http://api.example.com:8080", nil, false},
+		{"other host", "http://evil.example.com", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveHost(Policy{AllowedMethods: []string{"POST"}}, "POST", "api.example.com", tt.origin, tt.header)
+
+			if tt.allowed {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+			} else {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestMiddleware_NoOrigin(t *testing.T) {
+	w := serve(testPolicy, "GET", "", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMiddleware_Preflight(t *testing.T) {
+	header := http.Header{
+		"Access-Control-Request-Method":  {"POST"},
+		"Access-Control-Request-Headers": {"content-type"},
+	}
+
+	w := serve(testPolicy, "OPTIONS", "http://localhost:3000", header)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "http://localhost:3000", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type, X-Request-ID", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+	assert.Equal(t, []string{"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"}, w.Header().Values("Vary"))
+}
+
+func TestMiddleware_PreflightUnknownOrigin(t *testing.T) {
+	header := http.Header{"Access-Control-Request-Method": {"POST"}}
+
+	w := serve(testPolicy, "OPTIONS", "https://evil.com", header)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+}
+
+func TestMiddleware_AnyOrigin(t *testing.T) {
+	p := testPolicy
+	p.AllowedOrigins = []string{"*"}
+
+	w := serve(p, "GET", "https://anything.com", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Vary"))
+}
+
+func TestMiddleware_Credentials(t *testing.T) {
+	p := testPolicy
+	p.AllowCredentials = true
+
+	w := serve(p, "GET", "https://app.example.com", nil)
+
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestMiddleware_CredentialsReflectAnyOrigin(t *testing.T) {
+	p := testPolicy
+	p.AllowedOrigins = []string{"*"}
+	p.AllowCredentials = true
+
+	w := serve(p, "GET", "https://anything.com", nil)
+
+	// browsers reject "*" on credentialed requests
+	assert.Equal(t, "https://anything.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+}
+
+func TestPolicy_WithMethodsAndHeaders(t *testing.T) {
+	p := testPolicy.
+		WithMethods("PUT").
+		WithHeaders([]string{"Authorization"}, nil)
+
+	assert.Equal(t, []string{"PUT"}, p.AllowedMethods)
+	assert.Equal(t, []string{"Authorization"}, p.AllowedHeaders)
+	assert.Nil(t, p.ExposedHeaders)
+	assert.Equal(t, []string{"GET", "POST"}, testPolicy.AllowedMethods)
+}
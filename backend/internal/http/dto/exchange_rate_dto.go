@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type ExchangeRateDTO struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      string    `json:"rate"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
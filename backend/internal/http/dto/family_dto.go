@@ -0,0 +1,6 @@
+package dto
+
+type FamilyDTO struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+type FamilyItemsDTO struct {
+	Family  FamilyDTO          `json:"family"`
+	Options []VariantOptionDTO `json:"options"`
+	Items   []FamilyVariantDTO `json:"items"`
+}
+
+type VariantOptionDTO struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type FamilyVariantDTO struct {
+	Item       ItemSummaryDTO        `json:"item"`
+	ProductID  string                `json:"productId"`
+	Attributes []VariantAttributeDTO `json:"attributes"`
+}
+
+type VariantAttributeDTO struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
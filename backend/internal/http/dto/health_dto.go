@@ -0,0 +1,30 @@
+package dto
+
+// HealthDTO is the detailed health view of /health.
+type HealthDTO struct {
+	Status string                `json:"status"`
+	Build  BuildInfoDTO          `json:"build"`
+	Checks []DependencyHealthDTO `json:"checks"`
+}
+
+type BuildInfoDTO struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
+
+type DependencyHealthDTO struct {
+	Name      string                 `json:"name"`
+	Status    string                 `json:"status"`
+	LatencyMs float64                `json:"latencyMs"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// ProbeDTO is the body of /livez and /readyz. Checks lists the status of
+// each dependency on readiness probes.
+type ProbeDTO struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+type InstallmentPlanDTO struct {
+	PaymentMethodID       string   `json:"paymentMethodId"`
+	PaymentType           string   `json:"paymentType"`
+	Installments          int      `json:"installments"`
+	InstallmentAmount     MoneyDTO `json:"installmentAmount"`
+	LastInstallmentAmount MoneyDTO `json:"lastInstallmentAmount"`
+	TotalAmount           MoneyDTO `json:"totalAmount"`
+	InterestRate          float64  `json:"interestRate"`
+	InterestFree          bool     `json:"interestFree"`
+}
@@ -4,6 +4,7 @@ package dto
 type ItemDTO struct {
 	RatingInfo          RatingInfoDTO          `json:"ratingInfo"`
 	RatingDistribution  []RatingBucketDTO      `json:"ratingDistribution"`
+	Family              FamilyDTO              `json:"family"`
 	Description         string                 `json:"description"`
 	Questions           []QuestionDTO          `json:"questions"`
 	Seller              SellerDTO              `json:"seller"`
@@ -11,4 +12,5 @@ type ItemDTO struct {
 	Images              []ImageDTO             `json:"images"`
 	CharacteristicsInfo CharacteristicsInfoDTO `json:"characteristicsInfo"`
 	PaymentInfo         PaymentInfoDTO         `json:"paymentInfo"`
+	ExchangeRate        *ExchangeRateDTO       `json:"exchangeRate,omitempty"`
 }
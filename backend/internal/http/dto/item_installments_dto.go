@@ -0,0 +1,16 @@
+package dto
+
+type ItemInstallmentsDTO struct {
+	ItemID         string                         `json:"itemId"`
+	Price          MoneyDTO                       `json:"price"`
+	PaymentMethods []PaymentMethodInstallmentsDTO `json:"paymentMethods"`
+	BestPlan       *InstallmentPlanDTO            `json:"bestPlan,omitempty"`
+	ExchangeRate   *ExchangeRateDTO               `json:"exchangeRate,omitempty"`
+}
+
+type PaymentMethodInstallmentsDTO struct {
+	ID    string               `json:"id"`
+	Type  string               `json:"type"`
+	Image string               `json:"image"`
+	Plans []InstallmentPlanDTO `json:"plans"`
+}
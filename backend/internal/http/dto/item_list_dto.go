@@ -0,0 +1,7 @@
+package dto
+
+type ItemListDTO struct {
+	Items         []ItemSummaryDTO  `json:"items"`
+	NextCursor    string            `json:"nextCursor,omitempty"`
+	ExchangeRates []ExchangeRateDTO `json:"exchangeRates,omitempty"`
+}
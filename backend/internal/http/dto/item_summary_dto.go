@@ -0,0 +1,11 @@
+package dto
+
+type ItemSummaryDTO struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Price       MoneyDTO `json:"price"`
+	Image       ImageDTO `json:"image"`
+	Rating      float64  `json:"rating"`
+	ReviewCount int      `json:"reviewCount"`
+	Status      string   `json:"status"`
+}
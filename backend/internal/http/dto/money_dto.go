@@ -0,0 +1,9 @@
+package dto
+
+// MoneyDTO carries the amount as a decimal string, e.g. "3137310.00", so no
+// precision is lost in JSON numbers.
+type MoneyDTO struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	Symbol   string `json:"symbol"`
+}
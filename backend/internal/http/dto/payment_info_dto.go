@@ -3,4 +3,6 @@ package dto
 type PaymentInfoDTO struct {
 	Installments   int                `json:"installments"`
 	PaymentMethods []PaymentMethodDTO `json:"paymentMethods"`
+	// BestInstallmentPlan is the plan to advertise, e.g. "12 cuotas sin interés de $X"
+	BestInstallmentPlan *InstallmentPlanDTO `json:"bestInstallmentPlan,omitempty"`
 }
@@ -0,0 +1,11 @@
+package dto
+
+// ProblemDTO is an RFC 7807 problem details body.
+type ProblemDTO struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	TraceID  string `json:"traceId,omitempty"`
+}
@@ -1,7 +1,12 @@
 package dto
 
+import "time"
+
 type QuestionDTO struct {
-	Question string `json:"question"`
-	Answer   string `json:"answer"`
-	Date     string `json:"date"` // ej. "20/08/2025"
+	ID         string     `json:"id"`
+	Question   string     `json:"question"`
+	Answer     string     `json:"answer"`
+	Date       string     `json:"date"` // ej. "20/08/2025"
+	CreatedAt  time.Time  `json:"createdAt"`
+	AnsweredAt *time.Time `json:"answeredAt,omitempty"`
 }
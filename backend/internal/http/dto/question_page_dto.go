@@ -0,0 +1,6 @@
+package dto
+
+type QuestionPageDTO struct {
+	Questions  []QuestionDTO `json:"questions"`
+	NextCursor string        `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type CreateQuestionRequestDTO struct {
+	Question string `json:"question" binding:"required"`
+}
+
+type AnswerQuestionRequestDTO struct {
+	Answer string `json:"answer" binding:"required"`
+}
@@ -5,4 +5,5 @@ type RatingInfoDTO struct {
 	TotalRatings  int               `json:"totalRatings"`
 	Distribution  []RatingBucketDTO `json:"distribution"`
 	Reviews       []ReviewDTO       `json:"reviews"`
+	NextCursor    string            `json:"reviewsNextCursor,omitempty"`
 }
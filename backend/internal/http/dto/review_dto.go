@@ -1,6 +1,7 @@
 package dto
 
 type ReviewDTO struct {
+	ID      string `json:"id"`
 	Rating  int    `json:"rating"`
 	Comment string `json:"comment"`
 	Date    string `json:"date"` // ej. "08 ago. 2025"
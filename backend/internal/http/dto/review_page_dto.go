@@ -0,0 +1,6 @@
+package dto
+
+type ReviewPageDTO struct {
+	Reviews    []ReviewDTO `json:"reviews"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type CreateReviewRequestDTO struct {
+	Rating  int    `json:"rating" binding:"required"`
+	Comment string `json:"comment"`
+}
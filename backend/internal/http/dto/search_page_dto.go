@@ -0,0 +1,6 @@
+package dto
+
+type SearchPageDTO struct {
+	Results    []SearchResultDTO `json:"results"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+}
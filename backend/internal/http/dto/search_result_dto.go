@@ -0,0 +1,8 @@
+package dto
+
+type SearchResultDTO struct {
+	Item           ItemSummaryDTO `json:"item"`
+	Rank           float64        `json:"rank"`
+	TitleHighlight string         `json:"titleHighlight"`
+	Snippet        string         `json:"snippet"`
+}
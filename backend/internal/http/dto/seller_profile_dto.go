@@ -0,0 +1,25 @@
+package dto
+
+type SellerProfileDTO struct {
+	ID                  string           `json:"id"`
+	Seller              SellerDTO        `json:"seller"`
+	Reputation          string           `json:"reputation"`
+	CategoryDescription string           `json:"categoryDescription"`
+	Stats               SellerStatsDTO   `json:"stats"`
+	RatingInfo          SellerRatingDTO  `json:"ratingInfo"`
+	Items               []ItemSummaryDTO `json:"items"`
+	NextCursor          string           `json:"nextCursor,omitempty"`
+}
+
+type SellerStatsDTO struct {
+	ProductsCount    int `json:"productsCount"`
+	ItemsCount       int `json:"itemsCount"`
+	ActiveItemsCount int `json:"activeItemsCount"`
+	ReviewsCount     int `json:"reviewsCount"`
+}
+
+type SellerRatingDTO struct {
+	OverallRating float64           `json:"overallRating"`
+	TotalRatings  int               `json:"totalRatings"`
+	Distribution  []RatingBucketDTO `json:"distribution"`
+}
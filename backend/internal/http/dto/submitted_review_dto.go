@@ -0,0 +1,7 @@
+package dto
+
+type SubmittedReviewDTO struct {
+	Review        ReviewDTO `json:"review"`
+	OverallRating float64   `json:"overallRating"`
+	TotalRatings  int       `json:"totalRatings"`
+}
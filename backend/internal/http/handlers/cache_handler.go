@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/problem"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ItemCache interface {
+	Invalidate(itemID string) bool
+	InvalidateAll()
+}
+
+// CacheHandler serves the admin endpoints dropping cached items, for changes
+// made to the database outside the API.
+type CacheHandler struct {
+	itemCache ItemCache
+}
+
+func NewCacheHandler(itemCache ItemCache) *CacheHandler {
+	return &CacheHandler{
+		itemCache: itemCache,
+	}
+}
+
+// InvalidateItem drops an item. It succeeds whether it was cached or not.
+func (h *CacheHandler) InvalidateItem(c *gin.Context) {
+	itemID := c.Param("id")
+	if itemID == "" {
+		problem.Abort(c, domain.NewInvalidArgument("Item ID is required"))
+		return
+	}
+
+	h.itemCache.Invalidate(itemID)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *CacheHandler) InvalidateItems(c *gin.Context) {
+	h.itemCache.InvalidateAll()
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemCache struct {
+	mock.Mock
+}
+
+func (m *MockItemCache) Invalidate(itemID string) bool {
+	return m.Called(itemID).Bool(0)
+}
+
+func (m *MockItemCache) InvalidateAll() {
+	m.Called()
+}
+
+func TestCacheHandler_InvalidateItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockCache := &MockItemCache{}
+	handler := NewCacheHandler(mockCache)
+	mockCache.On("Invalidate", "MLA1").Return(false)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/admin/cache/items/MLA1", nil)
+	c.Params = gin.Params{{Key: "id", Value: "MLA1"}}
+
+	handler.InvalidateItem(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockCache.AssertExpectations(t)
+}
+
+func TestCacheHandler_InvalidateItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockCache := &MockItemCache{}
+	handler := NewCacheHandler(mockCache)
+	mockCache.On("InvalidateAll").Return()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("DELETE", "/admin/cache/items", nil)
+
+	handler.InvalidateItems(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	mockCache.AssertExpectations(t)
+}
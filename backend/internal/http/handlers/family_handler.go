@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type FamilyService interface {
+	GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error)
+}
+
+type FamilyHandler struct {
+	familyService FamilyService
+}
+
+func NewFamilyHandler(familyService FamilyService) *FamilyHandler {
+	return &FamilyHandler{
+		familyService: familyService,
+	}
+}
+
+func (h *FamilyHandler) GetItems(c *gin.Context) {
+	familyID := c.Param("id")
+	if familyID == "" {
+		problem.Abort(c, domain.NewInvalidArgument("Family ID is required"))
+		return
+	}
+
+	familyItems, err := h.familyService.GetItems(c.Request.Context(), familyID)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToFamilyItems(familyItems))
+}
+
+func (h *FamilyHandler) mapToFamilyItems(familyItems *domain.FamilyItems) dto.FamilyItemsDTO {
+	return dto.FamilyItemsDTO{
+		Family: dto.FamilyDTO{
+			ID:    familyItems.Family.ID,
+			Title: familyItems.Family.Title,
+		},
+		Options: lo.Map(familyItems.Options, func(option domain.VariantOption, _ int) dto.VariantOptionDTO {
+			return dto.VariantOptionDTO{
+				Name:   option.Name,
+				Values: option.Values,
+			}
+		}),
+		Items: lo.Map(familyItems.Variants, func(variant domain.FamilyVariant, _ int) dto.FamilyVariantDTO {
+			return h.mapToFamilyVariantDTO(variant)
+		}),
+	}
+}
+
+func (h *FamilyHandler) mapToFamilyVariantDTO(variant domain.FamilyVariant) dto.FamilyVariantDTO {
+	return dto.FamilyVariantDTO{
+		Item:      newItemSummaryDTO(variant.Item),
+		ProductID: variant.ProductID,
+		Attributes: lo.Map(variant.Attributes, func(attribute domain.VariantAttribute, _ int) dto.VariantAttributeDTO {
+			return dto.VariantAttributeDTO{
+				Name:  attribute.Name,
+				Value: attribute.Value,
+			}
+		}),
+	}
+}
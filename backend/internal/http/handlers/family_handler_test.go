@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFamilyService struct {
+	mock.Mock
+}
+
+func (m *MockFamilyService) GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error) {
+	args := m.Called(familyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.FamilyItems), args.Error(1)
+}
+
+func TestFamilyHandler_GetItems_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockFamilyService{}
+	mockService.On("GetItems", "family-id").Return(&domain.FamilyItems{
+		Family:  domain.Family{ID: "family-id", Title: "Celulares y Smartphones"},
+		Options: []domain.VariantOption{{Name: "Color", Values: []string{"Light Pink", "Onyx Black"}}},
+		Variants: []domain.FamilyVariant{
+			{
+				Item:       domain.ItemSummary{ID: "item-1"},
+				ProductID:  "product-1",
+				Attributes: []domain.VariantAttribute{{Name: "Color", Value: "Light Pink"}},
+			},
+		},
+	}, nil)
+
+	handler := NewFamilyHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/families/family-id/items", nil)
+	c.Params = gin.Params{{Key: "id", Value: "family-id"}}
+
+	handler.GetItems(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Celulares y Smartphones"`)
+	assert.Contains(t, w.Body.String(), `"attributes":[{"name":"Color","value":"Light Pink"}]`)
+	mockService.AssertExpectations(t)
+}
+
+func TestFamilyHandler_GetItems_EmptyID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewFamilyHandler(&MockFamilyService{})
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/families//items", nil)
+	c.Params = gin.Params{{Key: "id", Value: ""}}
+
+	handler.GetItems(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFamilyHandler_GetItems_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockFamilyService{}
+	mockService.On("GetItems", "missing").Return(nil, domain.ErrNotFound)
+
+	handler := NewFamilyHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/families/missing/items", nil)
+	c.Params = gin.Params{{Key: "id", Value: "missing"}}
+
+	handler.GetItems(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestFamilyHandler_GetItems_ServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockFamilyService{}
+	mockService.On("GetItems", "family-id").Return(nil, assert.AnError)
+
+	handler := NewFamilyHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/families/family-id/items", nil)
+	c.Params = gin.Params{{Key: "id", Value: "family-id"}}
+
+	handler.GetItems(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/buildinfo"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type HealthService interface {
+	Check(ctx context.Context) domain.HealthReport
+}
+
+type HealthHandler struct {
+	healthService HealthService
+	build         buildinfo.Info
+}
+
+func NewHealthHandler(healthService HealthService, build buildinfo.Info) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		build:         build,
+	}
+}
+
+// Live answers as long as the process can serve HTTP. It checks no
+// dependency, so a database outage does not get the process restarted.
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.ProbeDTO{Status: string(domain.HealthStatusUp)})
+}
+
+// Ready answers 503 while any dependency is down, so no traffic is routed to
+// an instance that cannot serve it.
+func (h *HealthHandler) Ready(c *gin.Context) {
+	report := h.healthService.Check(c.Request.Context())
+
+	checks := make(map[string]string, len(report.Dependencies))
+	for _, dependency := range report.Dependencies {
+		checks[dependency.Name] = string(dependency.Status)
+	}
+	c.JSON(statusOf(report), dto.ProbeDTO{Status: string(report.Status), Checks: checks})
+}
+
+// Health reports the build and the status, latency and details of every
+// dependency.
+func (h *HealthHandler) Health(c *gin.Context) {
+	report := h.healthService.Check(c.Request.Context())
+
+	c.JSON(statusOf(report), dto.HealthDTO{
+		Status: string(report.Status),
+		Build: dto.BuildInfoDTO{
+			Version:   h.build.Version,
+			Commit:    h.build.Commit,
+			BuildTime: h.build.BuildTime,
+			GoVersion: h.build.GoVersion,
+		},
+		Checks: lo.Map(report.Dependencies, func(dependency domain.DependencyHealth, _ int) dto.DependencyHealthDTO {
+			return dto.DependencyHealthDTO{
+				Name:      dependency.Name,
+				Status:    string(dependency.Status),
+				LatencyMs: float64(dependency.Latency.Microseconds()) / 1000,
+				Error:     dependency.Error,
+				Details:   dependency.Details,
+			}
+		}),
+	})
+}
+
+func statusOf(report domain.HealthReport) int {
+	if report.Status != domain.HealthStatusUp {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
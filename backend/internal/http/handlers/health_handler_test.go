@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"meli-backend/internal/buildinfo"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockHealthService struct {
+	mock.Mock
+}
+
+func (m *MockHealthService) Check(ctx context.Context) domain.HealthReport {
+	return m.Called().Get(0).(domain.HealthReport)
+}
+
+var testBuild = buildinfo.Info{Version: "1.2.0", Commit: "abc123", BuildTime: "2025-01-01T00:00:00Z", GoVersion: "go1.23.1"}
+
+func downReport() domain.HealthReport {
+	return domain.HealthReport{
+		Status: domain.HealthStatusDown,
+		Dependencies: []domain.DependencyHealth{
+			{Name: "database", Status: domain.HealthStatusDown, Latency: 1500 * time.Microsecond, Error: "connection refused"},
+			{Name: "migrations", Status: domain.HealthStatusUp, Details: map[string]interface{}{"version": "006"}},
+		},
+	}
+}
+
+func serveHealth(handler gin.HandlerFunc, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", target, nil)
+	handler(c)
+	return w
+}
+
+func TestHealthHandler_Live(t *testing.T) {
+	mockService := &MockHealthService{}
+	handler := NewHealthHandler(mockService, testBuild)
+
+	w := serveHealth(handler.Live, "/livez")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"up"}`, w.Body.String())
+	mockService.AssertNotCalled(t, "Check")
+}
+
+func TestHealthHandler_Ready(t *testing.T) {
+	mockService := &MockHealthService{}
+	mockService.On("Check").Return(domain.HealthReport{
+		Status:       domain.HealthStatusUp,
+		Dependencies: []domain.DependencyHealth{{Name: "database", Status: domain.HealthStatusUp}},
+	})
+	handler := NewHealthHandler(mockService, testBuild)
+
+	w := serveHealth(handler.Ready, "/readyz")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"up","checks":{"database":"up"}}`, w.Body.String())
+}
+
+func TestHealthHandler_Ready_Down(t *testing.T) {
+	mockService := &MockHealthService{}
+	mockService.On("Check").Return(downReport())
+	handler := NewHealthHandler(mockService, testBuild)
+
+	w := serveHealth(handler.Ready, "/readyz")
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"status":"down","checks":{"database":"down","migrations":"up"}}`, w.Body.String())
+}
+
+func TestHealthHandler_Health(t *testing.T) {
+	mockService := &MockHealthService{}
+	mockService.On("Check").Return(downReport())
+	handler := NewHealthHandler(mockService, testBuild)
+
+	w := serveHealth(handler.Health, "/health")
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	var body dto.HealthDTO
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "down", body.Status)
+	assert.Equal(t, dto.BuildInfoDTO{Version: "1.2.0", Commit: "abc123", BuildTime: "2025-01-01T00:00:00Z", GoVersion: "go1.23.1"}, body.Build)
+	assert.Equal(t, "database", body.Checks[0].Name)
+	assert.Equal(t, 1.5, body.Checks[0].LatencyMs)
+	assert.Equal(t, "connection refused", body.Checks[0].Error)
+	assert.Equal(t, "006", body.Checks[1].Details["version"])
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type InstallmentService interface {
+	GetByItem(ctx context.Context, itemID, currency string) (*domain.ItemInstallments, error)
+}
+
+type InstallmentHandler struct {
+	installmentService InstallmentService
+}
+
+func NewInstallmentHandler(installmentService InstallmentService) *InstallmentHandler {
+	return &InstallmentHandler{
+		installmentService: installmentService,
+	}
+}
+
+func (h *InstallmentHandler) GetByItem(c *gin.Context) {
+	itemID := c.Param("id")
+	if itemID == "" {
+		problem.Abort(c, domain.NewInvalidArgument("Item ID is required"))
+		return
+	}
+
+	currency, err := parseCurrency(c)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	installments, err := h.installmentService.GetByItem(c.Request.Context(), itemID, currency)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToItemInstallments(installments))
+}
+
+func (h *InstallmentHandler) mapToItemInstallments(installments *domain.ItemInstallments) dto.ItemInstallmentsDTO {
+	price := installments.Price
+
+	response := dto.ItemInstallmentsDTO{
+		ItemID:       installments.ItemID,
+		Price:        newMoneyDTO(price.Amount, price.CurrencySymbol),
+		ExchangeRate: newExchangeRateDTO(installments.ExchangeRate),
+		PaymentMethods: lo.Map(installments.PaymentMethods, func(method domain.PaymentMethodInstallments, _ int) dto.PaymentMethodInstallmentsDTO {
+			return dto.PaymentMethodInstallmentsDTO{
+				ID:    method.PaymentMethod.ID,
+				Type:  method.PaymentMethod.Type,
+				Image: method.PaymentMethod.Image.URLSmallVersion,
+				Plans: lo.Map(method.Plans, func(plan domain.InstallmentPlan, _ int) dto.InstallmentPlanDTO {
+					return newInstallmentPlanDTO(plan, price)
+				}),
+			}
+		}),
+	}
+
+	if installments.Best != nil {
+		best := newInstallmentPlanDTO(*installments.Best, price)
+		response.BestPlan = &best
+	}
+
+	return response
+}
+
+func newInstallmentPlanDTO(plan domain.InstallmentPlan, price domain.Price) dto.InstallmentPlanDTO {
+	return dto.InstallmentPlanDTO{
+		PaymentMethodID:       plan.PaymentMethodID,
+		PaymentType:           plan.PaymentType,
+		Installments:          plan.Installments,
+		InstallmentAmount:     newMoneyDTO(plan.InstallmentAmount, price.CurrencySymbol),
+		LastInstallmentAmount: newMoneyDTO(plan.LastInstallmentAmount, price.CurrencySymbol),
+		TotalAmount:           newMoneyDTO(plan.TotalAmount, price.CurrencySymbol),
+		InterestRate:          plan.InterestRatePercentage,
+		InterestFree:          plan.InterestFree,
+	}
+}
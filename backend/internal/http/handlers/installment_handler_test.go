@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockInstallmentService struct {
+	mock.Mock
+}
+
+func (m *MockInstallmentService) GetByItem(ctx context.Context, itemID, currency string) (*domain.ItemInstallments, error) {
+	args := m.Called(itemID, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemInstallments), args.Error(1)
+}
+
+func TestInstallmentHandler_GetByItem_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	installments := domain.NewItemInstallments(domain.ItemPaymentTerms{
+		ItemID: "item-id",
+		Price:  domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(1200), "ARS"), CurrencySymbol: "$"},
+		PaymentGroup: domain.PaymentGroup{PaymentMethods: []domain.PaymentMethod{
+			{ID: "visa", Type: "credit", NumberOfInstallments: 12},
+		}},
+	})
+
+	mockService := &MockInstallmentService{}
+	mockService.On("GetByItem", "item-id", "").Return(&installments, nil)
+
+	handler := NewInstallmentHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/item-id/installments", nil)
+	c.Params = gin.Params{{Key: "id", Value: "item-id"}}
+
+	handler.GetByItem(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"bestPlan":{"paymentMethodId":"visa","paymentType":"credit","installments":12,"installmentAmount":{"amount":"100.00","currency":"ARS","symbol":"$"},"lastInstallmentAmount":{"amount":"100.00","currency":"ARS","symbol":"$"},"totalAmount":{"amount":"1200.00","currency":"ARS","symbol":"$"},"interestRate":0,"interestFree":true}`)
+	mockService.AssertExpectations(t)
+}
+
+func TestInstallmentHandler_GetByItem_EmptyID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewInstallmentHandler(&MockInstallmentService{})
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items//installments", nil)
+	c.Params = gin.Params{{Key: "id", Value: ""}}
+
+	handler.GetByItem(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestInstallmentHandler_GetByItem_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockInstallmentService{}
+	mockService.On("GetByItem", "missing", "").Return(nil, domain.ErrNotFound)
+
+	handler := NewInstallmentHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/missing/installments", nil)
+	c.Params = gin.Params{{Key: "id", Value: "missing"}}
+
+	handler.GetByItem(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestInstallmentHandler_GetByItem_ServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockInstallmentService{}
+	mockService.On("GetByItem", "item-id", "").Return(nil, assert.AnError)
+
+	handler := NewInstallmentHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/item-id/installments", nil)
+	c.Params = gin.Params{{Key: "id", Value: "item-id"}}
+
+	handler.GetByItem(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -1,57 +1,188 @@
 package handlers
 
 import (
+	"context"
+	"maps"
 	"meli-backend/internal/domain"
 	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
 )
 
 type ItemService interface {
-	GetEnriched(id string) (*domain.Item, error)
+	GetEnriched(ctx context.Context, id, currency string) (*domain.Item, error)
+	List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error)
 }
 
 type ItemHandler struct {
 	itemService ItemService
+	// cacheMaxAge is how long clients may reuse an item detail without
+	// revalidating it.
+	cacheMaxAge time.Duration
 }
 
-func NewItemHandler(itemService ItemService) *ItemHandler {
+func NewItemHandler(itemService ItemService, cacheMaxAge time.Duration) *ItemHandler {
 	return &ItemHandler{
 		itemService: itemService,
+		cacheMaxAge: cacheMaxAge,
 	}
 }
 
 func (h *ItemHandler) GetByID(c *gin.Context) {
 	itemID := c.Param("id")
 	if itemID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error":   "Item ID is required",
-		})
+		problem.Abort(c, domain.NewInvalidArgument("Item ID is required"))
 		return
 	}
 
-	item, err := h.itemService.GetEnriched(itemID)
+	currency, err := parseCurrency(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"success": false,
-			"error":   "Item not found",
-		})
+		problem.Abort(c, err)
+		return
+	}
+
+	item, err := h.itemService.GetEnriched(c.Request.Context(), itemID, currency)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	writeCacheable(c, h.cacheMaxAge, h.mapToResponse(item))
+}
+
+func (h *ItemHandler) List(c *gin.Context) {
+	query, err := h.parseListQuery(c)
+	if err != nil {
+		problem.Abort(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, h.mapToResponse(item))
+	page, err := h.itemService.List(c.Request.Context(), query)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToItemList(page))
+}
+
+func (h *ItemHandler) parseListQuery(c *gin.Context) (domain.ItemListQuery, error) {
+	query := domain.ItemListQuery{
+		Sort:   domain.ItemSort(c.DefaultQuery("sort", string(domain.ItemSortNewest))),
+		Cursor: c.Query("cursor"),
+		Limit:  domain.DefaultPageLimit,
+		Filter: domain.ItemFilter{
+			FamilyID: c.Query("family_id"),
+			Status:   c.Query("status"),
+			SellerID: c.Query("seller_id"),
+		},
+	}
+
+	if !lo.Contains(domain.ItemSorts, query.Sort) {
+		return query, invalidArgument("sort must be one of %v", domain.ItemSorts)
+	}
+
+	if query.Filter.Status != "" && !lo.Contains(domain.ProductStatuses, query.Filter.Status) {
+		return query, invalidArgument("status must be one of %v", domain.ProductStatuses)
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		return query, err
+	}
+	query.Limit = limit
+
+	minPrice, err := parseOptionalFloat(c, "min_price")
+	if err != nil {
+		return query, err
+	}
+	maxPrice, err := parseOptionalFloat(c, "max_price")
+	if err != nil {
+		return query, err
+	}
+	if minPrice != nil && maxPrice != nil && *minPrice > *maxPrice {
+		return query, invalidArgument("min_price must not be greater than max_price")
+	}
+	query.Filter.MinPrice = minPrice
+	query.Filter.MaxPrice = maxPrice
+
+	currency, err := parseCurrency(c)
+	if err != nil {
+		return query, err
+	}
+	query.Currency = currency
+
+	return query, nil
+}
+
+func (h *ItemHandler) mapToItemList(page *domain.ItemSummaryPage) dto.ItemListDTO {
+	return dto.ItemListDTO{
+		Items: lo.Map(page.Items, func(item domain.ItemSummary, _ int) dto.ItemSummaryDTO {
+			return newItemSummaryDTO(item)
+		}),
+		NextCursor: page.NextCursor,
+		ExchangeRates: lo.Map(page.ExchangeRates, func(rate domain.ExchangeRate, _ int) dto.ExchangeRateDTO {
+			return *newExchangeRateDTO(&rate)
+		}),
+	}
+}
+
+func newItemSummaryDTO(item domain.ItemSummary) dto.ItemSummaryDTO {
+	return dto.ItemSummaryDTO{
+		ID:    item.ID,
+		Title: item.Title,
+		Price: newMoneyDTO(item.Price.Amount, item.Price.CurrencySymbol),
+		Image: dto.ImageDTO{
+			URLSmallVersion:  item.PrimaryImage.URLSmallVersion,
+			URLMediumVersion: item.PrimaryImage.URLMediumVersion,
+			Alt:              item.PrimaryImage.Alt,
+		},
+		Rating:      item.Rating,
+		ReviewCount: item.RatingCount,
+		Status:      item.Status,
+	}
+}
+
+func newMoneyDTO(money domain.Money, symbol string) dto.MoneyDTO {
+	return dto.MoneyDTO{
+		Amount:   money.Amount.StringFixed(domain.MoneyDecimalPlaces),
+		Currency: money.Currency,
+		Symbol:   symbol,
+	}
+}
+
+func newExchangeRateDTO(rate *domain.ExchangeRate) *dto.ExchangeRateDTO {
+	if rate == nil {
+		return nil
+	}
+	return &dto.ExchangeRateDTO{
+		From:      rate.BaseCurrency,
+		To:        rate.QuoteCurrency,
+		Rate:      rate.Rate.String(),
+		UpdatedAt: rate.UpdatedAt,
+	}
 }
 
 func (h *ItemHandler) mapToResponse(item *domain.Item) dto.ItemDTO {
+	distribution := h.mapToRatingDistribution(item.UserProduct.Product.RatingDistribution)
+
 	return dto.ItemDTO{
+		RatingDistribution: distribution,
 		RatingInfo: dto.RatingInfoDTO{
 			OverallRating: item.UserProduct.Product.AggregatedReview.RatingValue,
 			TotalRatings:  item.UserProduct.Product.AggregatedReview.RatingCount,
-			Distribution:  h.calculateDistribution(item.Reviews),
+			Distribution:  distribution,
 			Reviews:       h.mapToReviews(item.Reviews),
+			NextCursor:    item.ReviewsNextCursor,
+		},
+		Family: dto.FamilyDTO{
+			ID:    item.UserProduct.Product.Family.ID,
+			Title: item.UserProduct.Product.Family.Title,
 		},
 		Description: item.Description,
 		Questions:   h.mapToQuestions(item.Questions),
@@ -69,7 +200,7 @@ func (h *ItemHandler) mapToResponse(item *domain.Item) dto.ItemDTO {
 			Title:       item.Title,
 			Rating:      item.UserProduct.Product.AggregatedReview.RatingValue,
 			ReviewCount: item.UserProduct.Product.AggregatedReview.RatingCount,
-			Price:       int64(item.Price.Value),
+			Price:       newMoneyDTO(item.Price.Amount, item.Price.CurrencySymbol),
 			Status:      item.ProductStatus,
 			SoldCount:   10,
 		},
@@ -79,21 +210,39 @@ func (h *ItemHandler) mapToResponse(item *domain.Item) dto.ItemDTO {
 			OtherCharacteristics: h.mapToOtherCharacteristics(item.UserProduct.Product.SecondarySpec),
 		},
 		PaymentInfo: dto.PaymentInfoDTO{
-			Installments:   h.getInstallments(item.UserProduct.Product.PaymentGroup),
-			PaymentMethods: h.mapToPaymentMethods(item.UserProduct.Product.PaymentGroup),
+			Installments:        h.getInstallments(item.UserProduct.Product.PaymentGroup),
+			PaymentMethods:      h.mapToPaymentMethods(item.UserProduct.Product.PaymentGroup),
+			BestInstallmentPlan: h.mapToBestInstallmentPlan(item),
 		},
+		ExchangeRate: newExchangeRateDTO(item.ExchangeRate),
 	}
 }
 
+func (h *ItemHandler) mapToBestInstallmentPlan(item *domain.Item) *dto.InstallmentPlanDTO {
+	installments := domain.NewItemInstallments(domain.ItemPaymentTerms{
+		ItemID:       item.ID,
+		Price:        item.Price,
+		PaymentGroup: item.UserProduct.Product.PaymentGroup,
+	})
+	if installments.Best == nil {
+		return nil
+	}
+
+	plan := newInstallmentPlanDTO(*installments.Best, item.Price)
+	return &plan
+}
+
 func (h *ItemHandler) mapToPaymentMethods(paymentGroup domain.PaymentGroup) []dto.PaymentMethodDTO {
 	paymentMethods := paymentGroup.PaymentMethods
 	mapTypeToPaymentMethod := h.groupMethodsByType(paymentMethods)
 
+	// ranged in type order: the ETag hashes the response, which must not
+	// change with the map iteration order
 	dtos := []dto.PaymentMethodDTO{}
-	for key, value := range mapTypeToPaymentMethod {
+	for _, key := range slices.Sorted(maps.Keys(mapTypeToPaymentMethod)) {
 		dtos = append(dtos, dto.PaymentMethodDTO{
 			Title:  key,
-			Images: h.mapToPaymentMethodImage(value),
+			Images: h.mapToPaymentMethodImage(mapTypeToPaymentMethod[key]),
 		})
 	}
 
@@ -173,10 +322,17 @@ func (h *ItemHandler) mapToQuestions(questions []domain.Question) []dto.Question
 }
 
 func (h *ItemHandler) mapToQuestionDTO(question domain.Question) dto.QuestionDTO {
+	return newQuestionDTO(question)
+}
+
+func newQuestionDTO(question domain.Question) dto.QuestionDTO {
 	return dto.QuestionDTO{
-		Question: question.Question,
-		Answer:   question.Answer,
-		Date:     question.CreatedAt.Format("02 Jan 2006"),
+		ID:         question.ID,
+		Question:   question.Question,
+		Answer:     question.Answer,
+		Date:       question.CreatedAt.Format("02 Jan 2006"),
+		CreatedAt:  question.CreatedAt,
+		AnsweredAt: question.AnsweredAt,
 	}
 }
 
@@ -187,33 +343,28 @@ func (h *ItemHandler) mapToReviews(reviews []domain.Review) []dto.ReviewDTO {
 }
 
 func (h *ItemHandler) mapToReviewDTO(review domain.Review) dto.ReviewDTO {
+	return newReviewDTO(review)
+}
+
+func newReviewDTO(review domain.Review) dto.ReviewDTO {
 	return dto.ReviewDTO{
+		ID:      review.ID,
 		Rating:  review.Rating,
 		Comment: review.Content,
 		Date:    review.CreatedAt.Format("02 Jan 2006"),
 	}
 }
 
-func (h *ItemHandler) calculateDistribution(reviews []domain.Review) []dto.RatingBucketDTO {
-	counts := make(map[int]int)
-	for _, review := range reviews {
-		counts[review.Rating]++
-	}
-
-	sum := 0
-	for _, count := range counts {
-		sum += count
-	}
-
-	distribution := []dto.RatingBucketDTO{}
+func (h *ItemHandler) mapToRatingDistribution(buckets []domain.RatingBucket) []dto.RatingBucketDTO {
+	return newRatingDistributionDTO(buckets)
+}
 
-	for rating, count := range counts {
-		percentage := (count / sum) * 100
-		distribution = append(distribution, dto.RatingBucketDTO{
-			Rating:     rating,
-			Count:      count,
-			Percentage: percentage,
-		})
-	}
-	return distribution
+func newRatingDistributionDTO(buckets []domain.RatingBucket) []dto.RatingBucketDTO {
+	return lo.Map(buckets, func(bucket domain.RatingBucket, _ int) dto.RatingBucketDTO {
+		return dto.RatingBucketDTO{
+			Rating:     bucket.Rating,
+			Count:      bucket.Count,
+			Percentage: bucket.Percentage,
+		}
+	})
 }
@@ -1,31 +1,45 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockItemService struct {
 	mock.Mock
 }
 
-func (m *MockItemService) GetEnriched(id string) (*domain.Item, error) {
-	args := m.Called(id)
+func (m *MockItemService) GetEnriched(ctx context.Context, id, currency string) (*domain.Item, error) {
+	args := m.Called(id, currency)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.Item), args.Error(1)
 }
 
+func (m *MockItemService) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemSummaryPage), args.Error(1)
+}
+
 func TestNewItemHandler(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockService, handler.itemService)
@@ -37,12 +51,13 @@ func TestItemHandler_GetByID_Success(t *testing.T) {
 	mockService := &MockItemService{}
 	expectedItem := createMockItem()
 
-	mockService.On("GetEnriched", "test-item-id").Return(expectedItem, nil)
+	mockService.On("GetEnriched", "test-item-id", "").Return(expectedItem, nil)
 
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id", nil)
 	c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
 
 	handler.GetByID(c)
@@ -51,14 +66,120 @@ func TestItemHandler_GetByID_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestItemHandler_GetByID_ETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-item-id", "").Return(createMockItem(), nil)
+	handler := NewItemHandler(mockService, time.Minute)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id", nil)
+		if ifNoneMatch != "" {
+			c.Request.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+		handler.GetByID(c)
+		c.Writer.WriteHeaderNow()
+		return w
+	}
+
+	w := get("")
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+	assert.Equal(t, "public, max-age=60", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	// the same item gives the same ETag
+	assert.Equal(t, etag, get("").Header().Get("ETag"))
+
+	w = get(`"other", ` + etag)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+
+	w = get(`"other"`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEmpty(t, w.Body.String())
+}
+
+func TestItemHandler_GetByID_ETagStableWithSeveralPaymentTypes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	item := createMockItem()
+	item.UserProduct.Product.PaymentGroup.PaymentMethods = []domain.PaymentMethod{
+		{Type: "credit_card", NumberOfInstallments: 12, Image: domain.Image{URLSmallVersion: "visa.jpg"}},
+		{Type: "debit_card", NumberOfInstallments: 1, Image: domain.Image{URLSmallVersion: "maestro.jpg"}},
+		{Type: "transfer", NumberOfInstallments: 1, Image: domain.Image{URLSmallVersion: "pse.jpg"}},
+	}
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-item-id", "").Return(item, nil)
+	handler := NewItemHandler(mockService, time.Minute)
+
+	get := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id", nil)
+		c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+		handler.GetByID(c)
+		return w
+	}
+
+	first := get()
+	var response dto.ItemDTO
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &response))
+	titles := make([]string, 0, len(response.PaymentInfo.PaymentMethods))
+	for _, method := range response.PaymentInfo.PaymentMethods {
+		titles = append(titles, method.Title)
+	}
+	assert.Equal(t, []string{"credit_card", "debit_card", "transfer"}, titles)
+
+	// map iteration order is random, a few requests would tell it apart
+	for range 10 {
+		w := get()
+		assert.Equal(t, first.Header().Get("ETag"), w.Header().Get("ETag"))
+		assert.Equal(t, first.Body.String(), w.Body.String())
+	}
+}
+
+func TestItemHandler_GetByID_NoMaxAge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-item-id", "").Return(createMockItem(), nil)
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id", nil)
+	c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+
+	handler.GetByID(c)
+
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+}
+
+func TestEtagMatches(t *testing.T) {
+	assert.True(t, etagMatches(`"abc"`, `"abc"`))
+	assert.True(t, etagMatches(`"x", "abc"`, `"abc"`))
+	assert.True(t, etagMatches(`W/"abc"`, `"abc"`))
+	assert.True(t, etagMatches(`*`, `"abc"`))
+	assert.False(t, etagMatches(``, `"abc"`))
+	assert.False(t, etagMatches(`"abcd"`, `"abc"`))
+}
+
 func TestItemHandler_GetByID_EmptyID(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/", nil)
 	c.Params = gin.Params{{Key: "id", Value: ""}}
 
 	handler.GetByID(c)
@@ -66,27 +187,197 @@ func TestItemHandler_GetByID_EmptyID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
-func TestItemHandler_GetByID_ServiceError(t *testing.T) {
+func TestItemHandler_GetByID_NotFound(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
-	mockService.On("GetEnriched", "invalid-id").Return(nil, assert.AnError)
+	mockService.On("GetEnriched", "missing-id", "").Return(nil, domain.NewNotFound("item not found"))
 
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
-	c.Params = gin.Params{{Key: "id", Value: "invalid-id"}}
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/missing-id", nil)
+	c.Params = gin.Params{{Key: "id", Value: "missing-id"}}
 
 	handler.GetByID(c)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+	var body dto.ProblemDTO
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, http.StatusNotFound, body.Status)
+	assert.Equal(t, "item not found", body.Detail)
+	assert.Equal(t, "/api/v1/items/missing-id", body.Instance)
+	mockService.AssertExpectations(t)
+}
+
+func TestItemHandler_GetByID_ErrorStatuses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		detail string
+	}{
+		{"malformed id", domain.NewInvalidArgument("malformed identifier"), http.StatusBadRequest, "malformed identifier"},
+		{"database down", domain.NewUnavailable("database unavailable", assert.AnError), http.StatusServiceUnavailable, "database unavailable"},
+		{"unexpected error", assert.AnError, http.StatusInternalServerError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &MockItemService{}
+			mockService.On("GetEnriched", "item-id", "").Return(nil, tt.err)
+
+			handler := NewItemHandler(mockService, 0)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest("GET", "/api/v1/items/item-id", nil)
+			c.Params = gin.Params{{Key: "id", Value: "item-id"}}
+
+			handler.GetByID(c)
+
+			assert.Equal(t, tt.status, w.Code)
+
+			var body dto.ProblemDTO
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.detail, body.Detail)
+			assert.NotContains(t, w.Body.String(), assert.AnError.Error())
+		})
+	}
+}
+
+func TestItemHandler_List_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	minPrice := 1000.0
+	expectedQuery := domain.ItemListQuery{
+		Sort:   domain.ItemSortPriceAsc,
+		Cursor: "abc",
+		Limit:  5,
+		Filter: domain.ItemFilter{
+			FamilyID: "family-id",
+			Status:   "New",
+			SellerID: "seller-id",
+			MinPrice: &minPrice,
+		},
+	}
+	page := &domain.ItemSummaryPage{
+		Items: []domain.ItemSummary{
+			{ID: "item-1", Title: "Test Item", Price: domain.Price{Amount: domain.NewMoney(decimal.RequireFromString("1500.5"), "COP"), CurrencySymbol: "$"}},
+		},
+		NextCursor: "next-cursor",
+	}
+
+	mockService.On("List", expectedQuery).Return(page, nil)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items?sort=price_asc&cursor=abc&limit=5&family_id=family-id&status=New&seller_id=seller-id&min_price=1000", nil)
+
+	handler.List(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"next-cursor"`)
+	assert.Contains(t, w.Body.String(), `"price":{"amount":"1500.50","currency":"COP","symbol":"$"}`)
+	mockService.AssertExpectations(t)
+}
+
+func TestItemHandler_List_Defaults(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	expectedQuery := domain.ItemListQuery{
+		Sort:  domain.ItemSortNewest,
+		Limit: domain.DefaultPageLimit,
+	}
+
+	mockService.On("List", expectedQuery).Return(&domain.ItemSummaryPage{}, nil)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items", nil)
+
+	handler.List(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 	mockService.AssertExpectations(t)
 }
 
+func TestItemHandler_List_InvalidParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []string{
+		"sort=cheapest",
+		"status=Broken",
+		"limit=0",
+		"limit=500",
+		"min_price=abc",
+		"max_price=-1",
+		"min_price=10&max_price=5",
+	}
+
+	for _, rawQuery := range testCases {
+		mockService := &MockItemService{}
+		handler := NewItemHandler(mockService, 0)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/api/v1/items?"+rawQuery, nil)
+
+		handler.List(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, rawQuery)
+		mockService.AssertNotCalled(t, "List", mock.Anything)
+	}
+}
+
+func TestItemHandler_List_InvalidCursor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("List", mock.Anything).Return(nil, domain.ErrInvalidCursor)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items?cursor=bad", nil)
+
+	handler.List(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestItemHandler_List_ServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("List", mock.Anything).Return(nil, assert.AnError)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items", nil)
+
+	handler.List(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
 func TestItemHandler_MapToResponse(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	item := createMockItem()
 	result := handler.mapToResponse(item)
@@ -95,12 +386,14 @@ func TestItemHandler_MapToResponse(t *testing.T) {
 	assert.Equal(t, "Test Item", result.GeneralInfo.Title)
 	assert.Equal(t, "Test Description", result.Description)
 	assert.Equal(t, "Test Seller", result.Seller.SellerName)
-	assert.Equal(t, int64(9999), result.GeneralInfo.Price)
+	assert.Equal(t, dto.MoneyDTO{Amount: "9999.99", Currency: "COP", Symbol: "$"}, result.GeneralInfo.Price)
+	assert.Equal(t, "test-family-id", result.Family.ID)
+	assert.Equal(t, "Celulares y Smartphones", result.Family.Title)
 }
 
 func TestItemHandler_MapToPaymentMethods(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	paymentGroup := domain.PaymentGroup{
 		PaymentMethods: []domain.PaymentMethod{
@@ -117,7 +410,7 @@ func TestItemHandler_MapToPaymentMethods(t *testing.T) {
 
 func TestItemHandler_MapToPaymentMethods_Empty(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	paymentGroup := domain.PaymentGroup{
 		PaymentMethods: []domain.PaymentMethod{},
@@ -131,7 +424,7 @@ func TestItemHandler_MapToPaymentMethods_Empty(t *testing.T) {
 
 func TestItemHandler_GroupMethodsByType(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	paymentMethods := []domain.PaymentMethod{
 		{Type: "credit_card", NumberOfInstallments: 12},
@@ -149,7 +442,7 @@ func TestItemHandler_GroupMethodsByType(t *testing.T) {
 
 func TestItemHandler_GetInstallments(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	paymentGroup := domain.PaymentGroup{
 		PaymentMethods: []domain.PaymentMethod{
@@ -164,9 +457,41 @@ func TestItemHandler_GetInstallments(t *testing.T) {
 	assert.Equal(t, 12, result) // Should return the maximum number of installments
 }
 
+func TestItemHandler_MapToBestInstallmentPlan(t *testing.T) {
+	mockService := &MockItemService{}
+	handler := NewItemHandler(mockService, 0)
+
+	item := &domain.Item{
+		ID:    "item-id",
+		Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(1200), "ARS"), CurrencySymbol: "$"},
+		UserProduct: domain.UserProduct{Product: domain.Product{PaymentGroup: domain.PaymentGroup{
+			PaymentMethods: []domain.PaymentMethod{
+				{ID: "visa", Type: "credit", NumberOfInstallments: 12},
+				{ID: "debit", Type: "debit", NumberOfInstallments: 1},
+			},
+		}}},
+	}
+
+	result := handler.mapToBestInstallmentPlan(item)
+
+	assert.NotNil(t, result)
+	assert.Equal(t, 12, result.Installments)
+	assert.Equal(t, dto.MoneyDTO{Amount: "100.00", Currency: "ARS", Symbol: "$"}, result.InstallmentAmount)
+	assert.True(t, result.InterestFree)
+}
+
+func TestItemHandler_MapToBestInstallmentPlan_WithoutPaymentMethods(t *testing.T) {
+	mockService := &MockItemService{}
+	handler := NewItemHandler(mockService, 0)
+
+	result := handler.mapToBestInstallmentPlan(&domain.Item{})
+
+	assert.Nil(t, result)
+}
+
 func TestItemHandler_MapToOtherCharacteristics(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	specs := []domain.SecondarySpecItem{
 		{Item: "Color", Values: []domain.SecondarySpecValue{{Value: "Red"}}},
@@ -181,7 +506,7 @@ func TestItemHandler_MapToOtherCharacteristics(t *testing.T) {
 
 func TestItemHandler_MapToMainCharacteristics(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	specs := []domain.MainSpecItem{
 		{Item: "Brand", Value: "Nike", ImageIconURL: "brand-icon.png"},
@@ -196,7 +521,7 @@ func TestItemHandler_MapToMainCharacteristics(t *testing.T) {
 
 func TestItemHandler_MapItemImages(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	images := []domain.ItemImage{
 		{URLSmallVersion: "small1.jpg", URLMediumVersion: "medium1.jpg", Alt: "Image 1"},
@@ -213,7 +538,7 @@ func TestItemHandler_MapItemImages(t *testing.T) {
 
 func TestItemHandler_MapToImageDTO(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	image := domain.ItemImage{
 		URLSmallVersion:  "small.jpg",
@@ -231,7 +556,7 @@ func TestItemHandler_MapToImageDTO(t *testing.T) {
 
 func TestItemHandler_MapToQuestions(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	questions := []domain.Question{
 		{Question: "What is the warranty?", Answer: "2 years"},
@@ -248,7 +573,7 @@ func TestItemHandler_MapToQuestions(t *testing.T) {
 
 func TestItemHandler_MapToQuestionDTO(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	question := domain.Question{
 		Question: "Test question?",
@@ -264,7 +589,7 @@ func TestItemHandler_MapToQuestionDTO(t *testing.T) {
 
 func TestItemHandler_MapToReviews(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	reviews := []domain.Review{
 		{Rating: 5, Content: "Great product!"},
@@ -280,7 +605,7 @@ func TestItemHandler_MapToReviews(t *testing.T) {
 
 func TestItemHandler_MapToReviewDTO(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
 	review := domain.Review{
 		Rating:  4,
@@ -293,37 +618,51 @@ func TestItemHandler_MapToReviewDTO(t *testing.T) {
 	assert.Equal(t, 4, result.Rating)
 }
 
-func TestItemHandler_CalculateDistribution(t *testing.T) {
+func TestItemHandler_MapToRatingDistribution(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
+	handler := NewItemHandler(mockService, 0)
 
-	reviews := []domain.Review{
-		{Rating: 5}, {Rating: 5}, {Rating: 5},
-		{Rating: 4}, {Rating: 4},
-		{Rating: 3},
-		{Rating: 2},
-		{Rating: 1},
-	}
+	buckets := domain.NewRatingDistribution(map[int]int{5: 3, 4: 2, 3: 1, 2: 1, 1: 1})
 
-	result := handler.calculateDistribution(reviews)
+	result := handler.mapToRatingDistribution(buckets)
 
 	assert.NotNil(t, result)
 	assert.Len(t, result, 5)
+	assert.Equal(t, 5, result[0].Rating)
+	assert.Equal(t, 3, result[0].Count)
+	assert.Equal(t, 1, result[4].Rating)
 
+	sum := 0
+	for _, bucket := range result {
+		sum += bucket.Percentage
+	}
+	assert.Equal(t, 100, sum)
 }
 
-func TestItemHandler_CalculateDistribution_Empty(t *testing.T) {
+func TestItemHandler_MapToRatingDistribution_Empty(t *testing.T) {
 	mockService := &MockItemService{}
-	handler := NewItemHandler(mockService)
-
-	reviews := []domain.Review{}
+	handler := NewItemHandler(mockService, 0)
 
-	result := handler.calculateDistribution(reviews)
+	result := handler.mapToRatingDistribution([]domain.RatingBucket{})
 
 	assert.NotNil(t, result)
 	assert.Len(t, result, 0)
 }
 
+func TestItemHandler_MapToResponse_RatingDistribution(t *testing.T) {
+	mockService := &MockItemService{}
+	handler := NewItemHandler(mockService, 0)
+
+	item := createMockItem()
+	item.UserProduct.Product.RatingDistribution = domain.NewRatingDistribution(map[int]int{5: 1})
+
+	result := handler.mapToResponse(item)
+
+	assert.Len(t, result.RatingInfo.Distribution, 5)
+	assert.Equal(t, result.RatingInfo.Distribution, result.RatingDistribution)
+	assert.Equal(t, 100, result.RatingDistribution[0].Percentage)
+}
+
 // Helper function to create a mock item for testing
 func createMockItem() *domain.Item {
 	return &domain.Item{
@@ -333,6 +672,10 @@ func createMockItem() *domain.Item {
 		ProductStatus: "active",
 		UserProduct: domain.UserProduct{
 			Product: domain.Product{
+				Family: domain.Family{
+					ID:    "test-family-id",
+					Title: "Celulares y Smartphones",
+				},
 				AggregatedReview: domain.AggregatedReview{
 					RatingValue: 4.5,
 					RatingCount: 100,
@@ -363,7 +706,8 @@ func createMockItem() *domain.Item {
 			},
 		},
 		Price: domain.Price{
-			Value: 9999,
+			Amount:         domain.NewMoney(decimal.RequireFromString("9999.99"), "COP"),
+			CurrencySymbol: "$",
 		},
 		ItemImages: []domain.ItemImage{
 			{URLSmallVersion: "small1.jpg", URLMediumVersion: "medium1.jpg", Alt: "Image 1"},
@@ -376,3 +720,98 @@ func createMockItem() *domain.Item {
 		},
 	}
 }
+
+func TestItemHandler_GetByID_ConvertsCurrency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	item := createMockItem()
+	item.Price = domain.Price{Amount: domain.NewMoney(decimal.RequireFromString("2.50"), "USD"), CurrencySymbol: "US$"}
+	item.ExchangeRate = &domain.ExchangeRate{
+		BaseCurrency:  "COP",
+		QuoteCurrency: "USD",
+		Rate:          decimal.RequireFromString("0.00025"),
+		UpdatedAt:     time.Date(2025, 8, 24, 8, 18, 0, 0, time.UTC),
+	}
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-item-id", "USD").Return(item, nil)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id?currency=usd", nil)
+	c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+
+	handler.GetByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":{"amount":"2.50","currency":"USD","symbol":"US$"}`)
+	assert.Contains(t, w.Body.String(), `"exchangeRate":{"from":"COP","to":"USD","rate":"0.00025","updatedAt":"2025-08-24T08:18:00Z"}`)
+	mockService.AssertExpectations(t)
+}
+
+func TestItemHandler_GetByID_InvalidCurrency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id?currency=dollars", nil)
+	c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+
+	handler.GetByID(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetEnriched", mock.Anything, mock.Anything)
+}
+
+func TestItemHandler_GetByID_UnsupportedCurrency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-item-id", "XYZ").Return(nil, &domain.Error{
+		Kind:    domain.ErrInvalidArgument,
+		Message: "no exchange rate from ARS to XYZ",
+		Err:     domain.ErrUnsupportedCurrency,
+	})
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items/test-item-id?currency=XYZ", nil)
+	c.Params = gin.Params{{Key: "id", Value: "test-item-id"}}
+
+	handler.GetByID(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "no exchange rate from ARS to XYZ")
+}
+
+func TestItemHandler_List_Currency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("List", domain.ItemListQuery{
+		Sort:     domain.ItemSortNewest,
+		Limit:    domain.DefaultPageLimit,
+		Currency: "USD",
+	}).Return(&domain.ItemSummaryPage{
+		ExchangeRates: []domain.ExchangeRate{{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025")}},
+	}, nil)
+
+	handler := NewItemHandler(mockService, 0)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/items?currency=USD", nil)
+
+	handler.List(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"exchangeRates":[{"from":"COP","to":"USD","rate":"0.00025"`)
+	mockService.AssertExpectations(t)
+}
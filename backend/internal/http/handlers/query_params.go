@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"fmt"
+	"meli-backend/internal/domain"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseLimit reads the "limit" query parameter of paginated endpoints.
+func parseLimit(c *gin.Context) (int, error) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return domain.DefaultPageLimit, nil
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > domain.MaxPageLimit {
+		return 0, invalidArgument("limit must be between 1 and %d", domain.MaxPageLimit)
+	}
+	return limit, nil
+}
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// parseCurrency reads the optional "currency" query parameter of item
+// endpoints, an ISO 4217 code prices are converted to.
+func parseCurrency(c *gin.Context) (string, error) {
+	currency := strings.ToUpper(strings.TrimSpace(c.Query("currency")))
+	if currency != "" && !currencyPattern.MatchString(currency) {
+		return "", invalidArgument("currency must be an ISO 4217 code such as USD")
+	}
+	return currency, nil
+}
+
+func parseOptionalFloat(c *gin.Context, key string) (*float64, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || value < 0 {
+		return nil, invalidArgument("%s must be a non-negative number", key)
+	}
+	return &value, nil
+}
+
+// invalidArgument builds the error returned for a malformed request parameter.
+func invalidArgument(format string, args ...interface{}) error {
+	return domain.NewInvalidArgument(fmt.Sprintf(format, args...))
+}
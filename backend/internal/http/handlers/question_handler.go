@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type QuestionService interface {
+	Ask(ctx context.Context, itemID, question string) (*domain.Question, error)
+	Answer(ctx context.Context, questionID, answer string) (*domain.Question, error)
+	ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error)
+}
+
+type QuestionHandler struct {
+	questionService QuestionService
+}
+
+func NewQuestionHandler(questionService QuestionService) *QuestionHandler {
+	return &QuestionHandler{
+		questionService: questionService,
+	}
+}
+
+func (h *QuestionHandler) Create(c *gin.Context) {
+	itemID := c.Param("id")
+
+	var request dto.CreateQuestionRequestDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problem.Abort(c, domain.NewInvalidArgument("question is required"))
+		return
+	}
+
+	text, err := validateQuestionText("question", request.Question)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	question, err := h.questionService.Ask(c.Request.Context(), itemID, text)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, newQuestionDTO(*question))
+}
+
+func (h *QuestionHandler) Answer(c *gin.Context) {
+	questionID := c.Param("id")
+
+	var request dto.AnswerQuestionRequestDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problem.Abort(c, domain.NewInvalidArgument("answer is required"))
+		return
+	}
+
+	text, err := validateQuestionText("answer", request.Answer)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	question, err := h.questionService.Answer(c.Request.Context(), questionID, text)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newQuestionDTO(*question))
+}
+
+func (h *QuestionHandler) ListByItem(c *gin.Context) {
+	query := domain.QuestionListQuery{
+		ItemID: c.Param("id"),
+		Status: domain.QuestionStatus(c.Query("status")),
+		Cursor: c.Query("cursor"),
+	}
+
+	if !lo.Contains([]domain.QuestionStatus{
+		domain.QuestionStatusAll,
+		domain.QuestionStatusAnswered,
+		domain.QuestionStatusUnanswered,
+	}, query.Status) {
+		problem.Abort(c, domain.NewInvalidArgument("status must be answered or unanswered"))
+		return
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+	query.Limit = limit
+
+	page, err := h.questionService.ListByItem(c.Request.Context(), query)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.QuestionPageDTO{
+		Questions:  lo.Map(page.Questions, func(question domain.Question, _ int) dto.QuestionDTO { return newQuestionDTO(question) }),
+		NextCursor: page.NextCursor,
+	})
+}
+
+func validateQuestionText(field, text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", invalidArgument("%s is required", field)
+	}
+	if utf8.RuneCountInString(text) > domain.MaxQuestionLength {
+		return "", invalidArgument("%s must be at most %d characters", field, domain.MaxQuestionLength)
+	}
+	return text, nil
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/db/seeds"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	"meli-backend/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockQuestionService struct {
+	mock.Mock
+}
+
+func (m *MockQuestionService) Ask(ctx context.Context, itemID, question string) (*domain.Question, error) {
+	args := m.Called(itemID, question)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionService) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	args := m.Called(questionID, answer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionService) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QuestionPage), args.Error(1)
+}
+
+func newJSONContext(method, target, body string, params gin.Params) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(method, target, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	return c, w
+}
+
+func TestQuestionHandler_Create_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockQuestionService{}
+	mockService.On("Ask", "item-id", "Is it available?").Return(&domain.Question{
+		ID:        "question-id",
+		ItemID:    "item-id",
+		Question:  "Is it available?",
+		CreatedAt: time.Date(2025, 8, 24, 8, 0, 0, 0, time.UTC),
+	}, nil)
+
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("POST", "/api/v1/items/item-id/questions", `{"question": "  Is it available?  "}`, gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.Create(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"question-id"`)
+	assert.NotContains(t, w.Body.String(), "answeredAt")
+	mockService.AssertExpectations(t)
+}
+
+func TestQuestionHandler_Create_InvalidBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []string{
+		``,
+		`{}`,
+		`{"question": "   "}`,
+		`{"question": "` + strings.Repeat("a", domain.MaxQuestionLength+1) + `"}`,
+	}
+
+	for _, body := range testCases {
+		mockService := &MockQuestionService{}
+		handler := NewQuestionHandler(mockService)
+		c, w := newJSONContext("POST", "/api/v1/items/item-id/questions", body, gin.Params{{Key: "id", Value: "item-id"}})
+
+		handler.Create(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		mockService.AssertNotCalled(t, "Ask", mock.Anything, mock.Anything)
+	}
+}
+
+func TestQuestionHandler_Create_ItemNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockQuestionService{}
+	mockService.On("Ask", "missing", "Is it available?").Return(nil, domain.ErrNotFound)
+
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("POST", "/api/v1/items/missing/questions", `{"question": "Is it available?"}`, gin.Params{{Key: "id", Value: "missing"}})
+
+	handler.Create(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestQuestionHandler_Answer_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	answeredAt := time.Date(2025, 8, 24, 10, 0, 0, 0, time.UTC)
+	mockService := &MockQuestionService{}
+	mockService.On("Answer", "question-id", "Yes").Return(&domain.Question{
+		ID:         "question-id",
+		Question:   "Is it available?",
+		Answer:     "Yes",
+		AnsweredAt: &answeredAt,
+	}, nil)
+
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("PUT", "/api/v1/questions/question-id/answer", `{"answer": "Yes"}`, gin.Params{{Key: "id", Value: "question-id"}})
+
+	handler.Answer(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"answeredAt":"2025-08-24T10:00:00Z"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestQuestionHandler_Answer_AlreadyAnswered(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockQuestionService{}
+	mockService.On("Answer", "question-id", "Yes").Return(nil, domain.ErrConflict)
+
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("PUT", "/api/v1/questions/question-id/answer", `{"answer": "Yes"}`, gin.Params{{Key: "id", Value: "question-id"}})
+
+	handler.Answer(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestQuestionHandler_ListByItem_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockQuestionService{}
+	expectedQuery := domain.QuestionListQuery{
+		ItemID: "item-id",
+		Status: domain.QuestionStatusUnanswered,
+		Limit:  5,
+	}
+	mockService.On("ListByItem", expectedQuery).Return(&domain.QuestionPage{
+		Questions:  []domain.Question{{ID: "question-id", Question: "Is it available?"}},
+		NextCursor: "next-cursor",
+	}, nil)
+
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("GET", "/api/v1/items/item-id/questions?status=unanswered&limit=5", "", gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.ListByItem(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"next-cursor"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestQuestionHandler_ListByItem_InvalidStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockQuestionService{}
+	handler := NewQuestionHandler(mockService)
+	c, w := newJSONContext("GET", "/api/v1/items/item-id/questions?status=pending", "", gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.ListByItem(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ListByItem", mock.Anything)
+}
+
+func TestQuestionHandler_CreateAndList_WithMemoryRepository(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	store := repositories.NewMemoryStore()
+	require.NoError(t, store.LoadSeeds(seeds.FS))
+	handler := NewQuestionHandler(service.NewQuestionService(repositories.NewMemoryQuestionsRepository(store), nil))
+	params := gin.Params{{Key: "id", Value: "55747713-9cd4-45f7-a4cd-9916ed17a61d"}}
+
+	c, w := newJSONContext("POST", "/api/v1/items/55747713-9cd4-45f7-a4cd-9916ed17a61d/questions", `{"question": "¿Tiene garantía?"}`, params)
+	handler.Create(c)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	c, w = newJSONContext("GET", "/api/v1/items/55747713-9cd4-45f7-a4cd-9916ed17a61d/questions?status=unanswered", "", params)
+	handler.ListByItem(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "¿Tiene garantía?")
+	assert.NotContains(t, w.Body.String(), "Desde cuándo la pantalla")
+}
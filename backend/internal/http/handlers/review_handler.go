@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type ReviewService interface {
+	Submit(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error)
+	ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error)
+}
+
+type ReviewHandler struct {
+	reviewService ReviewService
+}
+
+func NewReviewHandler(reviewService ReviewService) *ReviewHandler {
+	return &ReviewHandler{
+		reviewService: reviewService,
+	}
+}
+
+func (h *ReviewHandler) Create(c *gin.Context) {
+	var request dto.CreateReviewRequestDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		problem.Abort(c, domain.NewInvalidArgument("rating is required"))
+		return
+	}
+
+	review := domain.NewReview{
+		ItemID:  c.Param("id"),
+		Rating:  request.Rating,
+		Content: strings.TrimSpace(request.Comment),
+	}
+	if err := validateNewReview(review); err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	submitted, err := h.reviewService.Submit(c.Request.Context(), review)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SubmittedReviewDTO{
+		Review:        newReviewDTO(submitted.Review),
+		OverallRating: submitted.AggregatedReview.RatingValue,
+		TotalRatings:  submitted.AggregatedReview.RatingCount,
+	})
+}
+
+func (h *ReviewHandler) ListByItem(c *gin.Context) {
+	query, err := h.parseListQuery(c)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	page, err := h.reviewService.ListByItem(c.Request.Context(), query)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReviewPageDTO{
+		Reviews:    lo.Map(page.Reviews, func(review domain.Review, _ int) dto.ReviewDTO { return newReviewDTO(review) }),
+		NextCursor: page.NextCursor,
+	})
+}
+
+func (h *ReviewHandler) parseListQuery(c *gin.Context) (domain.ReviewListQuery, error) {
+	query := domain.ReviewListQuery{
+		ItemID: c.Param("id"),
+		Scope:  domain.ReviewScope(c.DefaultQuery("scope", string(domain.ReviewScopeItem))),
+		Sort:   domain.ReviewSort(c.DefaultQuery("sort", string(domain.ReviewSortNewest))),
+		Cursor: c.Query("cursor"),
+	}
+
+	if !lo.Contains(domain.ReviewScopes, query.Scope) {
+		return query, invalidArgument("scope must be one of %v", domain.ReviewScopes)
+	}
+
+	if !lo.Contains(domain.ReviewSorts, query.Sort) {
+		return query, invalidArgument("sort must be one of %v", domain.ReviewSorts)
+	}
+
+	if raw := c.Query("rating"); raw != "" {
+		rating, err := strconv.Atoi(raw)
+		if err != nil || rating < domain.MinReviewRating || rating > domain.MaxReviewRating {
+			return query, invalidArgument("rating must be between %d and %d", domain.MinReviewRating, domain.MaxReviewRating)
+		}
+		query.Rating = rating
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		return query, err
+	}
+	query.Limit = limit
+
+	return query, nil
+}
+
+func validateNewReview(review domain.NewReview) error {
+	if review.Rating < domain.MinReviewRating || review.Rating > domain.MaxReviewRating {
+		return invalidArgument("rating must be between %d and %d", domain.MinReviewRating, domain.MaxReviewRating)
+	}
+	if utf8.RuneCountInString(review.Content) > domain.MaxReviewContentLength {
+		return invalidArgument("comment must be at most %d characters", domain.MaxReviewContentLength)
+	}
+	return nil
+}
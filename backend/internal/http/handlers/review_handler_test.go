@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReviewService struct {
+	mock.Mock
+}
+
+func (m *MockReviewService) Submit(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	args := m.Called(review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SubmittedReview), args.Error(1)
+}
+
+func (m *MockReviewService) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ReviewPage), args.Error(1)
+}
+
+func TestReviewHandler_Create_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockReviewService{}
+	mockService.On("Submit", domain.NewReview{ItemID: "item-id", Rating: 4, Content: "Good phone"}).Return(&domain.SubmittedReview{
+		Review:           domain.Review{ID: "review-id", Rating: 4, Content: "Good phone"},
+		AggregatedReview: domain.AggregatedReview{RatingValue: 4.5, RatingCount: 2},
+	}, nil)
+
+	handler := NewReviewHandler(mockService)
+	c, w := newJSONContext("POST", "/api/v1/items/item-id/reviews", `{"rating": 4, "comment": " Good phone "}`, gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.Create(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"overallRating":4.5`)
+	assert.Contains(t, w.Body.String(), `"totalRatings":2`)
+	mockService.AssertExpectations(t)
+}
+
+func TestReviewHandler_Create_InvalidBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []string{
+		``,
+		`{"comment": "no rating"}`,
+		`{"rating": 0}`,
+		`{"rating": 6}`,
+		`{"rating": -1}`,
+		`{"rating": 5, "comment": "` + strings.Repeat("a", domain.MaxReviewContentLength+1) + `"}`,
+	}
+
+	for _, body := range testCases {
+		mockService := &MockReviewService{}
+		handler := NewReviewHandler(mockService)
+		c, w := newJSONContext("POST", "/api/v1/items/item-id/reviews", body, gin.Params{{Key: "id", Value: "item-id"}})
+
+		handler.Create(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		mockService.AssertNotCalled(t, "Submit", mock.Anything)
+	}
+}
+
+func TestReviewHandler_Create_ItemNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockReviewService{}
+	mockService.On("Submit", mock.Anything).Return(nil, domain.ErrNotFound)
+
+	handler := NewReviewHandler(mockService)
+	c, w := newJSONContext("POST", "/api/v1/items/missing/reviews", `{"rating": 5}`, gin.Params{{Key: "id", Value: "missing"}})
+
+	handler.Create(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestReviewHandler_Create_ServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockReviewService{}
+	mockService.On("Submit", mock.Anything).Return(nil, assert.AnError)
+
+	handler := NewReviewHandler(mockService)
+	c, w := newJSONContext("POST", "/api/v1/items/item-id/reviews", `{"rating": 5}`, gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.Create(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestReviewHandler_ListByItem_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockReviewService{}
+	expectedQuery := domain.ReviewListQuery{
+		ItemID: "item-id",
+		Scope:  domain.ReviewScopeProduct,
+		Sort:   domain.ReviewSortHighest,
+		Rating: 5,
+		Cursor: "abc",
+		Limit:  10,
+	}
+	mockService.On("ListByItem", expectedQuery).Return(&domain.ReviewPage{
+		Reviews:    []domain.Review{{ID: "review-id", Rating: 5, Content: "Great"}},
+		NextCursor: "next-cursor",
+	}, nil)
+
+	handler := NewReviewHandler(mockService)
+	c, w := newJSONContext("GET", "/api/v1/items/item-id/reviews?scope=product&sort=highest&rating=5&cursor=abc&limit=10", "", gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.ListByItem(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"next-cursor"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestReviewHandler_ListByItem_Defaults(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockReviewService{}
+	mockService.On("ListByItem", domain.ReviewListQuery{
+		ItemID: "item-id",
+		Scope:  domain.ReviewScopeItem,
+		Sort:   domain.ReviewSortNewest,
+		Limit:  domain.DefaultPageLimit,
+	}).Return(&domain.ReviewPage{}, nil)
+
+	handler := NewReviewHandler(mockService)
+	c, w := newJSONContext("GET", "/api/v1/items/item-id/reviews", "", gin.Params{{Key: "id", Value: "item-id"}})
+
+	handler.ListByItem(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestReviewHandler_ListByItem_InvalidParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []string{
+		"scope=seller",
+		"sort=oldest",
+		"rating=0",
+		"rating=6",
+		"rating=five",
+		"limit=101",
+	}
+
+	for _, rawQuery := range testCases {
+		mockService := &MockReviewService{}
+		handler := NewReviewHandler(mockService)
+		c, w := newJSONContext("GET", "/api/v1/items/item-id/reviews?"+rawQuery, "", gin.Params{{Key: "id", Value: "item-id"}})
+
+		handler.ListByItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, rawQuery)
+		mockService.AssertNotCalled(t, "ListByItem", mock.Anything)
+	}
+}
+
+func TestReviewHandler_ListByItem_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		err    error
+		status int
+	}{
+		{domain.ErrNotFound, http.StatusNotFound},
+		{domain.ErrInvalidCursor, http.StatusBadRequest},
+		{assert.AnError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		mockService := &MockReviewService{}
+		mockService.On("ListByItem", mock.Anything).Return(nil, tc.err)
+
+		handler := NewReviewHandler(mockService)
+		c, w := newJSONContext("GET", "/api/v1/items/item-id/reviews", "", gin.Params{{Key: "id", Value: "item-id"}})
+
+		handler.ListByItem(c)
+
+		assert.Equal(t, tc.status, w.Code, tc.err.Error())
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error)
+}
+
+type SearchHandler struct {
+	searchService SearchService
+}
+
+func NewSearchHandler(searchService SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+func (h *SearchHandler) Search(c *gin.Context) {
+	query, err := h.parseSearchQuery(c)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	page, err := h.searchService.Search(c.Request.Context(), query)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToSearchPage(page))
+}
+
+func (h *SearchHandler) parseSearchQuery(c *gin.Context) (domain.SearchQuery, error) {
+	query := domain.SearchQuery{
+		Text:   strings.TrimSpace(c.Query("q")),
+		Cursor: c.Query("cursor"),
+	}
+
+	if query.Text == "" {
+		return query, invalidArgument("q is required")
+	}
+	if utf8.RuneCountInString(query.Text) > domain.MaxSearchTextLength {
+		return query, invalidArgument("q must be at most %d characters", domain.MaxSearchTextLength)
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		return query, err
+	}
+	query.Limit = limit
+
+	return query, nil
+}
+
+func (h *SearchHandler) mapToSearchPage(page *domain.SearchPage) dto.SearchPageDTO {
+	return dto.SearchPageDTO{
+		Results: lo.Map(page.Results, func(result domain.SearchResult, _ int) dto.SearchResultDTO {
+			return dto.SearchResultDTO{
+				Item:           newItemSummaryDTO(result.Item),
+				Rank:           result.Rank,
+				TitleHighlight: result.TitleHighlight,
+				Snippet:        result.Snippet,
+			}
+		}),
+		NextCursor: page.NextCursor,
+	}
+}
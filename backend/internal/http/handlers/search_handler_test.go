@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSearchService struct {
+	mock.Mock
+}
+
+func (m *MockSearchService) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SearchPage), args.Error(1)
+}
+
+func TestSearchHandler_Search_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockSearchService{}
+	expectedQuery := domain.SearchQuery{Text: "samsung 256gb", Limit: 5}
+	page := &domain.SearchPage{
+		Results: []domain.SearchResult{
+			{
+				Item:           domain.ItemSummary{ID: "item-1", Title: "Samsung Galaxy S24+ 256gb"},
+				Rank:           0.8,
+				TitleHighlight: "<mark>Samsung</mark> Galaxy S24+ <mark>256gb</mark>",
+			},
+		},
+		NextCursor: "next-cursor",
+	}
+
+	mockService.On("Search", expectedQuery).Return(page, nil)
+
+	handler := NewSearchHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/search?q=samsung+256gb&limit=5", nil)
+
+	handler.Search(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"next-cursor"`)
+	assert.Contains(t, w.Body.String(), `"id":"item-1"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestSearchHandler_Search_InvalidParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []string{
+		"",
+		"q=++",
+		"q=samsung&limit=0",
+		"q=" + strings.Repeat("a", domain.MaxSearchTextLength+1),
+	}
+
+	for _, rawQuery := range testCases {
+		mockService := &MockSearchService{}
+		handler := NewSearchHandler(mockService)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest("GET", "/api/v1/search?"+rawQuery, nil)
+
+		handler.Search(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, rawQuery)
+		mockService.AssertNotCalled(t, "Search", mock.Anything)
+	}
+}
+
+func TestSearchHandler_Search_InvalidCursor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockSearchService{}
+	mockService.On("Search", mock.Anything).Return(nil, domain.ErrInvalidCursor)
+
+	handler := NewSearchHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/search?q=samsung&cursor=bad", nil)
+
+	handler.Search(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSearchHandler_Search_ServiceError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockSearchService{}
+	mockService.On("Search", mock.Anything).Return(nil, assert.AnError)
+
+	handler := NewSearchHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/v1/search?q=samsung", nil)
+
+	handler.Search(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/problem"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type SellerService interface {
+	GetProfile(ctx context.Context, query domain.SellerProfileQuery) (*domain.SellerProfile, error)
+}
+
+type SellerHandler struct {
+	sellerService SellerService
+}
+
+func NewSellerHandler(sellerService SellerService) *SellerHandler {
+	return &SellerHandler{
+		sellerService: sellerService,
+	}
+}
+
+func (h *SellerHandler) GetByID(c *gin.Context) {
+	sellerID := c.Param("id")
+	if sellerID == "" {
+		problem.Abort(c, domain.NewInvalidArgument("Seller ID is required"))
+		return
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	profile, err := h.sellerService.GetProfile(c.Request.Context(), domain.SellerProfileQuery{
+		SellerID: sellerID,
+		Cursor:   c.Query("cursor"),
+		Limit:    limit,
+	})
+	if err != nil {
+		problem.Abort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToSellerProfile(profile))
+}
+
+// mapToSellerProfile fills the embedded SellerDTO with the live counters
+// instead of the ones stored in the sellers table. Sales and followers have
+// no source other than the table.
+func (h *SellerHandler) mapToSellerProfile(profile *domain.SellerProfile) dto.SellerProfileDTO {
+	seller := profile.Seller
+
+	return dto.SellerProfileDTO{
+		ID: seller.ID,
+		Seller: dto.SellerDTO{
+			SellerName:            seller.Name,
+			SellerImageURL:        seller.Image.URLSmallVersion,
+			FollowersCount:        seller.NumberOfFollowers,
+			ProductsCount:         profile.Stats.ProductsCount,
+			Rating:                profile.Stats.AverageRating,
+			SalesCount:            seller.NumberOfSales,
+			AttentionDescription:  seller.AttentionDescription,
+			PuntualityDescription: seller.PuntualityDescription,
+		},
+		Reputation:          seller.Reputation,
+		CategoryDescription: seller.CategoryDescription,
+		Stats: dto.SellerStatsDTO{
+			ProductsCount:    profile.Stats.ProductsCount,
+			ItemsCount:       profile.Stats.ItemsCount,
+			ActiveItemsCount: profile.Stats.ActiveItemsCount,
+			ReviewsCount:     profile.Stats.ReviewsCount,
+		},
+		RatingInfo: dto.SellerRatingDTO{
+			OverallRating: profile.Stats.AverageRating,
+			TotalRatings:  profile.Stats.ReviewsCount,
+			Distribution:  newRatingDistributionDTO(profile.RatingDistribution),
+		},
+		Items: lo.Map(profile.Items.Items, func(item domain.ItemSummary, _ int) dto.ItemSummaryDTO {
+			return newItemSummaryDTO(item)
+		}),
+		NextCursor: profile.Items.NextCursor,
+	}
+}
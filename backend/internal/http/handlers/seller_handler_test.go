@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSellerService struct {
+	mock.Mock
+}
+
+func (m *MockSellerService) GetProfile(ctx context.Context, query domain.SellerProfileQuery) (*domain.SellerProfile, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SellerProfile), args.Error(1)
+}
+
+func TestSellerHandler_GetByID_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockSellerService{}
+	mockService.On("GetProfile", domain.SellerProfileQuery{
+		SellerID: "seller-id",
+		Cursor:   "abc",
+		Limit:    5,
+	}).Return(&domain.SellerProfile{
+		Seller: domain.Seller{ID: "seller-id", Name: "Samsung", NumberOfProducts: 99, NumberOfSales: 1000},
+		Stats: domain.SellerStats{
+			ProductsCount:    2,
+			ItemsCount:       3,
+			ActiveItemsCount: 1,
+			ReviewsCount:     4,
+			AverageRating:    4.5,
+		},
+		RatingDistribution: domain.NewRatingDistribution(map[int]int{5: 2, 4: 2}),
+		Items: domain.ItemSummaryPage{
+			Items:      []domain.ItemSummary{{ID: "item-1"}},
+			NextCursor: "next",
+		},
+	}, nil)
+
+	handler := NewSellerHandler(mockService)
+
+	c, w := newJSONContext(http.MethodGet, "/api/v1/sellers/seller-id?cursor=abc&limit=5", "", gin.Params{{Key: "id", Value: "seller-id"}})
+
+	handler.GetByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"productsCount":2`)
+	assert.Contains(t, w.Body.String(), `"salesCount":1000`)
+	assert.Contains(t, w.Body.String(), `"activeItemsCount":1`)
+	assert.Contains(t, w.Body.String(), `"overallRating":4.5`)
+	assert.Contains(t, w.Body.String(), `{"rating":5,"count":2,"percentage":50}`)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"next"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestSellerHandler_GetByID_InvalidLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockSellerService{}
+	handler := NewSellerHandler(mockService)
+
+	c, w := newJSONContext(http.MethodGet, "/api/v1/sellers/seller-id?limit=0", "", gin.Params{{Key: "id", Value: "seller-id"}})
+
+	handler.GetByID(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetProfile", mock.Anything)
+}
+
+func TestSellerHandler_GetByID_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", domain.ErrNotFound, http.StatusNotFound},
+		{"invalid cursor", domain.ErrInvalidCursor, http.StatusBadRequest},
+		{"service error", assert.AnError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &MockSellerService{}
+			mockService.On("GetProfile", mock.Anything).Return(nil, tt.err)
+
+			handler := NewSellerHandler(mockService)
+
+			c, w := newJSONContext(http.MethodGet, "/api/v1/sellers/seller-id", "", gin.Params{{Key: "id", Value: "seller-id"}})
+
+			handler.GetByID(c)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package problem renders errors as RFC 7807 application/problem+json
+// responses.
+package problem
+
+import (
+	"errors"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/logging"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const ContentType = "application/problem+json"
+
+// Status maps an error to the HTTP status of its domain error kind. Errors
+// without a kind are internal errors.
+func Status(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrUnavailable):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// Abort records err on the context, so the request log includes it, and
+// writes it as a problem response with the status of its kind.
+func Abort(c *gin.Context, err error) {
+	AbortWithStatus(c, Status(err), err)
+}
+
+// AbortWithStatus is Abort for errors whose status is not given by a domain
+// error kind, such as unknown routes.
+func AbortWithStatus(c *gin.Context, status int, err error) {
+	_ = c.Error(err)
+	write(c, status, err)
+}
+
+// Middleware renders the last error attached with c.Error when the handler
+// chain did not write a response itself.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		err := c.Errors.Last().Err
+		write(c, Status(err), err)
+	}
+}
+
+// write renders the problem. The detail of internal errors is never returned,
+// since it may expose queries or other internals; it is only logged.
+func write(c *gin.Context, status int, err error) {
+	detail := domain.ErrorMessage(err)
+	if detail == "" && status < http.StatusInternalServerError {
+		detail = err.Error()
+	}
+
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(status, dto.ProblemDTO{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		TraceID:  logging.RequestID(c.Request.Context()),
+	})
+}
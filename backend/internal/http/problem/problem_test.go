@@ -0,0 +1,116 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/logging"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newContext(target string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", target, nil)
+	c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), "req-1"))
+	return c, w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) dto.ProblemDTO {
+	t.Helper()
+	var problem dto.ProblemDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	return problem
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{domain.NewInvalidArgument("limit must be between 1 and 100"), http.StatusBadRequest},
+		{domain.ErrInvalidCursor, http.StatusBadRequest},
+		{domain.NewNotFound("item not found"), http.StatusNotFound},
+		{domain.ErrNotFound, http.StatusNotFound},
+		{domain.NewConflict("question is already answered"), http.StatusConflict},
+		{domain.NewUnavailable("database unavailable", errors.New("connection refused")), http.StatusServiceUnavailable},
+		{errors.New("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.status, Status(tt.err), tt.err.Error())
+	}
+}
+
+func TestAbort_WritesProblem(t *testing.T) {
+	c, w := newContext("/api/v1/items/MLA1")
+
+	Abort(c, domain.NewNotFound("item not found"))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, dto.ProblemDTO{
+		Type:     "about:blank",
+		Title:    "Not Found",
+		Status:   http.StatusNotFound,
+		Detail:   "item not found",
+		Instance: "/api/v1/items/MLA1",
+		TraceID:  "req-1",
+	}, decode(t, w))
+	assert.True(t, c.IsAborted())
+	assert.Len(t, c.Errors, 1)
+}
+
+func TestAbort_HidesInternalDetail(t *testing.T) {
+	c, w := newContext("/api/v1/items")
+
+	Abort(c, errors.New(`pq: relation "items" does not exist`))
+
+	problem := decode(t, w)
+	assert.Equal(t, http.StatusInternalServerError, problem.Status)
+	assert.Empty(t, problem.Detail)
+	assert.NotContains(t, w.Body.String(), "relation")
+}
+
+func TestAbortWithStatus(t *testing.T) {
+	c, w := newContext("/unknown")
+
+	AbortWithStatus(c, http.StatusNotFound, errors.New("route not found"))
+
+	problem := decode(t, w)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, "route not found", problem.Detail)
+}
+
+func TestMiddleware_RendersUnwrittenErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(Middleware())
+	engine.GET("/pending", func(c *gin.Context) {
+		_ = c.Error(domain.NewUnavailable("database unavailable", errors.New("timeout")))
+	})
+	engine.GET("/written", func(c *gin.Context) {
+		_ = c.Error(errors.New("already handled"))
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/pending", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "database unavailable", decode(t, w).Detail)
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/written", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"ok":true}`, w.Body.String())
+}
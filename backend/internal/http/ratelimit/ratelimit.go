@@ -0,0 +1,153 @@
+// Package ratelimit throttles clients with token buckets: every client gets a
+// bucket of Burst tokens per route group, refilled at Rate tokens per second,
+// and each request takes one.
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"meli-backend/internal/http/problem"
+	"meli-backend/internal/logging"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader is the header clients identify themselves with.
+const APIKeyHeader = "X-API-Key"
+
+// Limit is the quota of a route group. The zero Limit disables limiting.
+type Limit struct {
+	// Rate is the number of tokens added to the bucket per second.
+	Rate float64
+	// Burst is the size of the bucket, the requests a client may send at
+	// once after being idle.
+	Burst int
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// fillTime is the time an empty bucket takes to be full again.
+func (l Limit) fillTime() time.Duration {
+	return durationOf(float64(l.Burst) / l.Rate)
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed bool
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int
+	// RetryAfter is the time until the next token when not allowed.
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+}
+
+// Store keeps the buckets. Implementations must take tokens atomically, as
+// concurrent requests of a client may hit several servers.
+type Store interface {
+	// Take takes a token from the bucket of key, creating it full if it
+	// does not exist.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// KeyFunc identifies the client of a request.
+type KeyFunc func(c *gin.Context) string
+
+// ClientKey returns a KeyFunc identifying clients by API key when they send
+// one of apiKeys, and by IP otherwise. Unknown keys are ignored so clients
+// cannot get a fresh bucket by sending random ones. c.ClientIP only honours
+// X-Forwarded-For and X-Real-IP from the trusted proxies of the engine.
+func ClientKey(apiKeys []string) KeyFunc {
+	known := make(map[string]string, len(apiKeys))
+	for _, key := range apiKeys {
+		// keys are hashed so they do not end up in the store
+		sum := sha256.Sum256([]byte(key))
+		known[key] = "key:" + hex.EncodeToString(sum[:8])
+	}
+	return func(c *gin.Context) string {
+		if key, ok := known[c.GetHeader(APIKeyHeader)]; ok {
+			return key
+		}
+		return "ip:" + c.ClientIP()
+	}
+}
+
+var errRateLimited = errors.New("rate limit exceeded")
+
+// Limiter applies limits to route groups.
+type Limiter struct {
+	store Store
+	key   KeyFunc
+}
+
+func NewLimiter(store Store, key KeyFunc) *Limiter {
+	return &Limiter{store: store, key: key}
+}
+
+// Middleware limits the requests of every client to the routes it is added
+// to. group names the bucket, so a client has a bucket per group. Requests
+// are let through when the store fails, as an outage of the store must not
+// take the API down.
+func (l *Limiter) Middleware(group string, limit Limit) gin.HandlerFunc {
+	if l == nil || !limit.enabled() {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	burst := strconv.Itoa(limit.Burst)
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		result, err := l.store.Take(ctx, group+":"+l.key(c), limit)
+		if err != nil {
+			logging.FromContext(ctx).Warn("rate limit store failed, request let through",
+				"group", group, "error", err)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", burst)
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(seconds(result.Reset)))
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(max(seconds(result.RetryAfter), 1)))
+			problem.AbortWithStatus(c, http.StatusTooManyRequests, errRateLimited)
+			return
+		}
+		c.Next()
+	}
+}
+
+// take is the token bucket algorithm shared by the stores: it refills the
+// bucket holding tokens since the elapsed time and takes a token from it.
+// It returns the tokens left.
+func take(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	burst := float64(limit.Burst)
+	tokens = math.Min(burst, tokens+elapsed.Seconds()*limit.Rate)
+
+	var result Result
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = durationOf((1 - tokens) / limit.Rate)
+	}
+	result.Remaining = int(tokens)
+	result.Reset = durationOf((burst - tokens) / limit.Rate)
+	return tokens, result
+}
+
+func durationOf(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// seconds rounds d up to whole seconds, as clients must not retry early.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
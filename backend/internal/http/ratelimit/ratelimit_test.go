@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit) (Result, error) {
+	return Result{}, errors.New("connection refused")
+}
+
+func newEngine(limiter *Limiter, limit Limit) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(limiter.Middleware("api", limit))
+	engine.GET("/items/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return engine
+}
+
+func get(engine *gin.Engine, header http.Header, remoteAddr string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/items/MLA1", nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.RemoteAddr = remoteAddr
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddleware_ThrottlesAfterBurst(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), ClientKey(nil))
+	engine := newEngine(limiter, Limit{Rate: 0.5, Burst: 2})
+
+	w := get(engine, nil, "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Reset"))
+
+	w = get(engine, nil, "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+
+	w = get(engine, nil, "10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "rate limit exceeded")
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+
+	// other clients have buckets of their own
+	w = get(engine, nil, "10.0.0.2:1234")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMiddleware_ZeroLimitDisabled(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), ClientKey(nil))
+	engine := newEngine(limiter, Limit{})
+
+	w := get(engine, nil, "10.0.0.1:1234")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestMiddleware_NilLimiterDisabled(t *testing.T) {
+	var limiter *Limiter
+	engine := newEngine(limiter, Limit{Rate: 1, Burst: 1})
+
+	w := get(engine, nil, "10.0.0.1:1234")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMiddleware_StoreFailureLetsRequestThrough(t *testing.T) {
+	limiter := NewLimiter(failingStore{}, ClientKey(nil))
+	engine := newEngine(limiter, Limit{Rate: 1, Burst: 1})
+
+	w := get(engine, nil, "10.0.0.1:1234")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestClientKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	assert.NoError(t, engine.SetTrustedProxies([]string{"10.0.0.0/8"}))
+	key := ClientKey([]string{"secret"})
+
+	var got string
+	engine.GET("/", func(c *gin.Context) {
+		got = key(c)
+	})
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		remoteAddr string
+		want       string
+	}{
+		{"remote address", nil, "203.0.113.7:1234", "ip:203.0.113.7"},
+		{"trusted proxy", http.Header{"X-Forwarded-For": {"198.51.100.1"}}, "10.0.0.1:1234", "ip:198.51.100.1"},
+		{"untrusted proxy", http.Header{"X-Forwarded-For": {"198.51.100.1"}}, "203.0.113.7:1234", "ip:203.0.113.7"},
+		{"unknown api key", http.Header{"X-Api-Key": {"random"}}, "203.0.113.7:1234", "ip:203.0.113.7"},
+		{"api key", http.Header{"X-Api-Key": {"secret"}}, "203.0.113.7:1234", "key:2bb80d537b1da3e3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.Header = tt.header
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			req.RemoteAddr = tt.remoteAddr
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	limit := Limit{Rate: 2, Burst: 4}
+
+	tokens, result := take(0.5, 0, limit)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0.5, tokens)
+	assert.Equal(t, 250*time.Millisecond, result.RetryAfter)
+	assert.Equal(t, 1750*time.Millisecond, result.Reset)
+
+	tokens, result = take(0.5, time.Second, limit)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1.5, tokens)
+	assert.Equal(t, 1, result.Remaining)
+
+	// refills never exceed the burst
+	tokens, result = take(1, time.Hour, limit)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 3.0, tokens)
+}
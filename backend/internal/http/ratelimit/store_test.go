@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStore runs the same scenario against every store. advance moves the
+// clock of the store forward.
+func testStore(t *testing.T, store Store, advance func(time.Duration)) {
+	t.Helper()
+	ctx := context.Background()
+	limit := Limit{Rate: 1, Burst: 2}
+
+	result, err := store.Take(ctx, "api:ip:10.0.0.1", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Equal(t, time.Second, result.Reset)
+
+	result, err = store.Take(ctx, "api:ip:10.0.0.1", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	result, err = store.Take(ctx, "api:ip:10.0.0.1", limit)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+	assert.Equal(t, 2*time.Second, result.Reset)
+
+	result, err = store.Take(ctx, "api:ip:10.0.0.2", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	advance(1500 * time.Millisecond)
+
+	result, err = store.Take(ctx, "api:ip:10.0.0.1", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	result, err = store.Take(ctx, "api:ip:10.0.0.1", limit)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newMemoryStore(func() time.Time { return now })
+
+	testStore(t, store, func(d time.Duration) { now = now.Add(d) })
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newMemoryStore(func() time.Time { return now })
+	ctx := context.Background()
+
+	_, _ = store.Take(ctx, "api:ip:10.0.0.1", Limit{Rate: 1, Burst: 10})
+	_, _ = store.Take(ctx, "api:ip:10.0.0.2", Limit{Rate: 0.01, Burst: 10})
+	assert.Equal(t, 2, store.len())
+
+	now = now.Add(sweepInterval)
+	_, _ = store.Take(ctx, "api:ip:10.0.0.3", Limit{Rate: 1, Burst: 10})
+
+	// 10.0.0.1 is full again, 10.0.0.2 needs 100s to refill
+	assert.Equal(t, 2, store.len())
+}
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	server.SetTime(now)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	store := NewRedisStore(client, "ratelimit:")
+
+	testStore(t, store, func(d time.Duration) {
+		now = now.Add(d)
+		server.SetTime(now)
+	})
+
+	assert.True(t, server.Exists("ratelimit:api:ip:10.0.0.1"))
+	assert.Equal(t, 3*time.Second, server.TTL("ratelimit:api:ip:10.0.0.1"))
+}
+
+func TestRedisStore_Unavailable(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	server.Close()
+
+	_, err := NewRedisStore(client, "ratelimit:").Take(context.Background(), "api:ip:10.0.0.1", Limit{Rate: 1, Burst: 1})
+
+	assert.ErrorContains(t, err, "taking rate limit token")
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"crypto/subtle"
+	"errors"
+	"meli-backend/internal/http/problem"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errUnauthorized = errors.New("a valid admin token is required")
+
+// adminAuth lets through requests sending token as a bearer token. It lets
+// none through when token is empty.
+func adminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", "Bearer")
+			problem.AbortWithStatus(c, http.StatusUnauthorized, errUnauthorized)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,76 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockItemCache struct {
+	mock.Mock
+}
+
+func (m *MockItemCache) Invalidate(itemID string) bool {
+	return m.Called(itemID).Bool(0)
+}
+
+func (m *MockItemCache) InvalidateAll() {
+	m.Called()
+}
+
+func serveAdmin(router *Router, target, token string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	router.engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestRouter_AdminCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockCache := &MockItemCache{}
+	mockCache.On("Invalidate", "MLA1").Return(true)
+	mockCache.On("InvalidateAll").Return()
+	router := NewRouter(Deps{ItemCache: mockCache, AdminToken: "s3cret"})
+
+	w := serveAdmin(router, "/admin/cache/items/MLA1", "s3cret")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	w = serveAdmin(router, "/admin/cache/items", "s3cret")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	mockCache.AssertExpectations(t)
+}
+
+func TestRouter_AdminCache_Unauthorized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockCache := &MockItemCache{}
+	router := NewRouter(Deps{ItemCache: mockCache, AdminToken: "s3cret"})
+
+	for _, token := range []string{"", "wrong"} {
+		w := serveAdmin(router, "/admin/cache/items", token)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	}
+	mockCache.AssertNotCalled(t, "InvalidateAll")
+}
+
+func TestRouter_AdminCache_DisabledWithoutToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{ItemCache: &MockItemCache{}})
+
+	w := serveAdmin(router, "/admin/cache/items", "")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,28 @@
+package router
+
+import (
+	"meli-backend/internal/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute labels requests that match no route, so scanners probing
+// random paths do not create a series per path.
+const unmatchedRoute = "unmatched"
+
+// requestMetrics records the count and latency of every request by route
+// pattern and status class.
+func requestMetrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.ObserveRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,47 @@
+package router
+
+import (
+	"meli-backend/internal/domain"
+	"meli-backend/internal/metrics"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_Metrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "MLA1", "").Return(&domain.Item{ID: "MLA1"}, nil)
+	mockService.On("GetEnriched", "MLA2", "").Return(nil, domain.NewNotFound("item not found"))
+
+	router := NewRouter(Deps{ItemService: mockService, Metrics: metrics.New()})
+
+	for _, path := range []string{"/api/v1/items/MLA1", "/api/v1/items/MLA2", "/wp-admin/1", "/wp-admin/2"} {
+		router.engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	w := httptest.NewRecorder()
+	router.engine.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `meli_http_requests_total{method="GET",route="/api/v1/items/:id",status_class="2xx"} 1`)
+	assert.Contains(t, body, `meli_http_requests_total{method="GET",route="/api/v1/items/:id",status_class="4xx"} 1`)
+	assert.Contains(t, body, `meli_http_requests_total{method="GET",route="unmatched",status_class="4xx"} 2`)
+	assert.NotContains(t, body, "MLA1")
+}
+
+func TestRouter_MetricsDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{})
+
+	w := httptest.NewRecorder()
+	router.engine.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"fmt"
+	"log/slog"
+	"meli-backend/internal/http/problem"
+	"meli-backend/internal/logging"
+	"meli-backend/internal/tracing"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// validRequestID limits the incoming IDs that are propagated, so clients
+// cannot inject arbitrary content into the logs.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestLogger propagates the X-Request-ID header, or generates one, and
+// attaches a logger carrying it, and the trace ID when the request is traced,
+// to the request context so handlers, services and repositories log with the
+// same IDs. It logs one entry per request.
+func requestLogger(base *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(requestIDHeader)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Header(requestIDHeader, requestID)
+
+		logger := base.With("request_id", requestID)
+		if traceID := tracing.TraceID(c.Request.Context()); traceID != "" {
+			logger = logger.With("trace_id", traceID)
+		}
+		ctx := logging.WithRequestID(c.Request.Context(), requestID)
+		c.Request = c.Request.WithContext(logging.WithLogger(ctx, logger))
+
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		attrs := []interface{}{
+			"method", c.Request.Method,
+			"route", c.FullPath(),
+			"path", c.Request.URL.Path,
+			"query", logging.RedactQuery(c.Request.URL.Query()),
+			"status", status,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"bytes", c.Writer.Size(),
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
+		}
+		// errors reported through problem.Abort keep their cause, which the
+		// response body only shows in client-safe terms
+		if err := c.Errors.Last(); err != nil {
+			attrs = append(attrs, "error", err.Err.Error())
+		}
+		logger.Log(c.Request.Context(), level, "request completed", attrs...)
+	}
+}
+
+// recovery turns a panic into a 500 response and logs it with its stack
+// trace through the request logger.
+func recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if err := recover(); err != nil {
+				logging.FromContext(c.Request.Context()).Error("panic recovered",
+					"error", err,
+					"stack", string(debug.Stack()),
+				)
+				problem.AbortWithStatus(c, http.StatusInternalServerError, fmt.Errorf("panic: %v", err))
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,131 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"meli-backend/internal/http/problem"
+	"meli-backend/internal/logging"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLoggedEngine(buf *bytes.Buffer, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(requestLogger(logging.NewWithWriter(buf, slog.LevelDebug)))
+	engine.Use(recovery())
+	engine.GET("/items/:id", handler)
+	return engine
+}
+
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRequestLogger_GeneratesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	engine := newLoggedEngine(&buf, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/items/MLA1", nil))
+
+	requestID := w.Header().Get(requestIDHeader)
+	_, err := uuid.Parse(requestID)
+	assert.NoError(t, err)
+
+	entries := logEntries(t, &buf)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "request completed", entries[0]["msg"])
+	assert.Equal(t, requestID, entries[0]["request_id"])
+	assert.Equal(t, "/items/:id", entries[0]["route"])
+	assert.Equal(t, float64(http.StatusOK), entries[0]["status"])
+}
+
+func TestRequestLogger_PropagatesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	engine := newLoggedEngine(&buf, func(c *gin.Context) {
+		logging.FromContext(c.Request.Context()).Info("handling item")
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/items/MLA1", nil)
+	req.Header.Set(requestIDHeader, "abc-123")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, "abc-123", w.Header().Get(requestIDHeader))
+	entries := logEntries(t, &buf)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "handling item", entries[0]["msg"])
+	assert.Equal(t, "abc-123", entries[0]["request_id"])
+	assert.Equal(t, "abc-123", entries[1]["request_id"])
+}
+
+func TestRequestLogger_ReplacesInvalidRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	engine := newLoggedEngine(&buf, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/items/MLA1", nil)
+	req.Header.Set(requestIDHeader, "bad id\n{\"level\":\"ERROR\"}")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	_, err := uuid.Parse(w.Header().Get(requestIDHeader))
+	assert.NoError(t, err)
+}
+
+func TestRequestLogger_LevelAndRedactedQuery(t *testing.T) {
+	var buf bytes.Buffer
+	engine := newLoggedEngine(&buf, func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/items/MLA1?currency=USD&token=secret", nil))
+
+	entries := logEntries(t, &buf)
+	assert.Equal(t, "WARN", entries[0]["level"])
+	assert.Equal(t, "currency=USD&token=%5BREDACTED%5D", entries[0]["query"])
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestRecovery_LogsPanic(t *testing.T) {
+	var buf bytes.Buffer
+	engine := newLoggedEngine(&buf, func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/items/MLA1", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+	assert.NotContains(t, w.Body.String(), "boom")
+
+	entries := logEntries(t, &buf)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "panic recovered", entries[0]["msg"])
+	assert.Equal(t, "boom", entries[0]["error"])
+	assert.Equal(t, w.Header().Get(requestIDHeader), entries[0]["request_id"])
+	assert.Equal(t, "ERROR", entries[1]["level"])
+	assert.Equal(t, "panic: boom", entries[1]["error"])
+}
@@ -2,19 +2,103 @@
 package router
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"meli-backend/internal/buildinfo"
 	"meli-backend/internal/domain"
+	"meli-backend/internal/http/cors"
 	"meli-backend/internal/http/handlers"
+	"meli-backend/internal/http/problem"
+	"meli-backend/internal/http/ratelimit"
+	"meli-backend/internal/metrics"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ItemService interface {
-	GetEnriched(string) (*domain.Item, error)
+	GetEnriched(context.Context, string, string) (*domain.Item, error)
+	List(context.Context, domain.ItemListQuery) (*domain.ItemSummaryPage, error)
+}
+
+type SearchService interface {
+	Search(context.Context, domain.SearchQuery) (*domain.SearchPage, error)
+}
+
+type FamilyService interface {
+	GetItems(context.Context, string) (*domain.FamilyItems, error)
+}
+
+type QuestionService interface {
+	Ask(context.Context, string, string) (*domain.Question, error)
+	Answer(context.Context, string, string) (*domain.Question, error)
+	ListByItem(context.Context, domain.QuestionListQuery) (*domain.QuestionPage, error)
+}
+
+type ReviewService interface {
+	Submit(context.Context, domain.NewReview) (*domain.SubmittedReview, error)
+	ListByItem(context.Context, domain.ReviewListQuery) (*domain.ReviewPage, error)
+}
+
+type SellerService interface {
+	GetProfile(context.Context, domain.SellerProfileQuery) (*domain.SellerProfile, error)
+}
+
+type InstallmentService interface {
+	GetByItem(context.Context, string, string) (*domain.ItemInstallments, error)
+}
+
+type ItemCache interface {
+	Invalidate(string) bool
+	InvalidateAll()
+}
+
+type HealthService interface {
+	Check(context.Context) domain.HealthReport
 }
 
 type Deps struct {
-	ItemService ItemService
+	ItemService        ItemService
+	SearchService      SearchService
+	FamilyService      FamilyService
+	QuestionService    QuestionService
+	ReviewService      ReviewService
+	SellerService      SellerService
+	InstallmentService InstallmentService
+	HealthService      HealthService
+	// ItemCache is the item cache invalidated by the admin endpoints. They
+	// are not served when nil or when AdminToken is empty.
+	ItemCache ItemCache
+	// AdminToken is the bearer token of the admin endpoints and of answering
+	// questions, which always returns 401 when it is empty.
+	AdminToken string
+	// ItemCacheMaxAge is how long clients may reuse an item detail.
+	ItemCacheMaxAge time.Duration
+	// Logger is the base logger of every request, slog.Default() when nil.
+	Logger *slog.Logger
+	// Metrics instruments requests and serves /metrics. Metrics are
+	// disabled when nil.
+	Metrics *metrics.Metrics
+	// CORS holds the allowed origins, credentials and preflight max age.
+	// Methods and headers are set per route group. No cross-origin
+	// request is allowed when it has no origins.
+	CORS cors.Policy
+	// TrustedProxies are the IPs or CIDRs whose X-Forwarded-For and
+	// X-Real-IP headers give the client IP. No proxy is trusted when empty.
+	TrustedProxies []string
+	// RateLimiter throttles clients to RateLimits. Requests are not limited
+	// when nil.
+	RateLimiter *ratelimit.Limiter
+	RateLimits  RateLimits
+}
+
+// RateLimits are the limits of the route groups. Requests to the item detail
+// are counted against both limits, as it is the most expensive endpoint.
+type RateLimits struct {
+	API  ratelimit.Limit
+	Item ratelimit.Limit
 }
 
 type Router struct {
@@ -23,12 +107,24 @@ type Router struct {
 }
 
 func NewRouter(deps Deps) *Router {
-	gin.SetMode(gin.ReleaseMode)
+	logger := deps.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
 
 	engine := gin.New()
-	engine.Use(gin.Logger())
-	engine.Use(gin.Recovery())
-	engine.Use(corsMiddleware())
+	engine.HandleMethodNotAllowed = true
+	if err := engine.SetTrustedProxies(deps.TrustedProxies); err != nil {
+		logger.Error("invalid trusted proxies, trusting none", "error", err)
+		_ = engine.SetTrustedProxies(nil)
+	}
+	engine.Use(requestTracing())
+	engine.Use(requestLogger(logger))
+	if deps.Metrics != nil {
+		engine.Use(requestMetrics(deps.Metrics))
+	}
+	engine.Use(recovery())
+	engine.Use(problem.Middleware())
 
 	r := &Router{
 		engine: engine,
@@ -42,42 +138,72 @@ func (r *Router) Handler() http.Handler {
 	return r.engine
 }
 
-func corsMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
-
-		if c.Request.Method == http.MethodOptions {
-			c.AbortWithStatus(http.StatusNoContent)
-			return
-		}
-		c.Next()
-	}
+// apiCORS is the CORS policy of the API group. Health and metrics
+// endpoints are not meant for browsers and have no CORS headers.
+func apiCORS(base cors.Policy) cors.Policy {
+	return base.
+		WithMethods(http.MethodGet, http.MethodPost, http.MethodPut).
+		WithHeaders(
+			[]string{"Content-Type", "Authorization", "X-Request-ID", "traceparent"},
+			[]string{"X-Request-ID", "traceparent"},
+		)
 }
 
 func (r *Router) setupRoutes() {
-	r.engine.GET("/health", r.healthCheckHandler)
+	healthHandler := handlers.NewHealthHandler(r.deps.HealthService, buildinfo.Get())
+	r.engine.GET("/livez", healthHandler.Live)
+	r.engine.GET("/readyz", healthHandler.Ready)
+	r.engine.GET("/health", healthHandler.Health)
+	if r.deps.Metrics != nil {
+		r.engine.GET("/metrics", gin.WrapH(r.deps.Metrics.Handler()))
+	}
 
-	v1 := r.engine.Group("/api/v1")
+	limiter := r.deps.RateLimiter
+	// CORS comes first so throttled browsers can read the 429
+	v1 := r.engine.Group("/api/v1",
+		cors.Middleware(apiCORS(r.deps.CORS)),
+		limiter.Middleware("api", r.deps.RateLimits.API),
+	)
 	{
-		itemHandler := handlers.NewItemHandler(r.deps.ItemService)
+		// gin only runs group middleware on matched routes, so preflight
+		// requests need a route of their own; the middleware answers them
+		v1.OPTIONS("/*path", func(c *gin.Context) {
+			c.AbortWithStatus(http.StatusNoContent)
+		})
+
+		itemHandler := handlers.NewItemHandler(r.deps.ItemService, r.deps.ItemCacheMaxAge)
+		searchHandler := handlers.NewSearchHandler(r.deps.SearchService)
+		familyHandler := handlers.NewFamilyHandler(r.deps.FamilyService)
+		questionHandler := handlers.NewQuestionHandler(r.deps.QuestionService)
+		reviewHandler := handlers.NewReviewHandler(r.deps.ReviewService)
+		sellerHandler := handlers.NewSellerHandler(r.deps.SellerService)
+		installmentHandler := handlers.NewInstallmentHandler(r.deps.InstallmentService)
+
+		v1.GET("/items", itemHandler.List)
+		v1.GET("/items/:id", limiter.Middleware("item", r.deps.RateLimits.Item), itemHandler.GetByID)
+		v1.GET("/items/:id/installments", installmentHandler.GetByItem)
+		v1.GET("/items/:id/questions", questionHandler.ListByItem)
+		v1.POST("/items/:id/questions", questionHandler.Create)
+		// there are no seller accounts yet: answering takes the admin token
+		v1.PUT("/questions/:id/answer", adminAuth(r.deps.AdminToken), questionHandler.Answer)
+		v1.GET("/items/:id/reviews", reviewHandler.ListByItem)
+		v1.POST("/items/:id/reviews", reviewHandler.Create)
+		v1.GET("/search", searchHandler.Search)
+		v1.GET("/families/:id/items", familyHandler.GetItems)
+		v1.GET("/sellers/:id", sellerHandler.GetByID)
+	}
 
-		v1.GET("/items/:id", itemHandler.GetByID)
+	if r.deps.ItemCache != nil && r.deps.AdminToken != "" {
+		cacheHandler := handlers.NewCacheHandler(r.deps.ItemCache)
+		admin := r.engine.Group("/admin", adminAuth(r.deps.AdminToken))
+		admin.DELETE("/cache/items", cacheHandler.InvalidateItems)
+		admin.DELETE("/cache/items/:id", cacheHandler.InvalidateItem)
 	}
 
 	r.engine.NoRoute(func(c *gin.Context) {
-		c.JSON(http.StatusNotFound, gin.H{
-			"success": false,
-			"error":   "route not found",
-		})
+		problem.AbortWithStatus(c, http.StatusNotFound, errors.New("route not found"))
 	})
-}
-
-func (r *Router) healthCheckHandler(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status":  "ok",
-		"message": "Server is running",
-		"version": "1.0.0",
+	r.engine.NoMethod(func(c *gin.Context) {
+		problem.AbortWithStatus(c, http.StatusMethodNotAllowed, errors.New("method not allowed"))
 	})
 }
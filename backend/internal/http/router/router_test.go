@@ -1,10 +1,17 @@
 package router
 
 import (
+	"context"
+	"encoding/json"
 	"meli-backend/internal/domain"
+	"meli-backend/internal/http/cors"
+	"meli-backend/internal/http/dto"
+	"meli-backend/internal/http/ratelimit"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -15,14 +22,126 @@ type MockItemService struct {
 	mock.Mock
 }
 
-func (m *MockItemService) GetEnriched(id string) (*domain.Item, error) {
-	args := m.Called(id)
+func (m *MockItemService) GetEnriched(ctx context.Context, id, currency string) (*domain.Item, error) {
+	args := m.Called(id, currency)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.Item), args.Error(1)
 }
 
+func (m *MockItemService) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemSummaryPage), args.Error(1)
+}
+
+type MockSearchService struct {
+	mock.Mock
+}
+
+func (m *MockSearchService) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SearchPage), args.Error(1)
+}
+
+type MockFamilyService struct {
+	mock.Mock
+}
+
+func (m *MockFamilyService) GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error) {
+	args := m.Called(familyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.FamilyItems), args.Error(1)
+}
+
+type MockQuestionService struct {
+	mock.Mock
+}
+
+func (m *MockQuestionService) Ask(ctx context.Context, itemID, question string) (*domain.Question, error) {
+	args := m.Called(itemID, question)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionService) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	args := m.Called(questionID, answer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionService) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QuestionPage), args.Error(1)
+}
+
+type MockReviewService struct {
+	mock.Mock
+}
+
+func (m *MockReviewService) Submit(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	args := m.Called(review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SubmittedReview), args.Error(1)
+}
+
+func (m *MockReviewService) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ReviewPage), args.Error(1)
+}
+
+type MockSellerService struct {
+	mock.Mock
+}
+
+func (m *MockSellerService) GetProfile(ctx context.Context, query domain.SellerProfileQuery) (*domain.SellerProfile, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SellerProfile), args.Error(1)
+}
+
+type MockInstallmentService struct {
+	mock.Mock
+}
+
+func (m *MockInstallmentService) GetByItem(ctx context.Context, itemID, currency string) (*domain.ItemInstallments, error) {
+	args := m.Called(itemID, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemInstallments), args.Error(1)
+}
+
+type MockHealthService struct {
+	mock.Mock
+}
+
+func (m *MockHealthService) Check(ctx context.Context) domain.HealthReport {
+	return m.Called().Get(0).(domain.HealthReport)
+}
+
 func TestNewRouter(t *testing.T) {
 	mockService := &MockItemService{}
 	deps := Deps{
@@ -51,21 +170,30 @@ func TestRouter_Handler(t *testing.T) {
 func TestRouter_HealthCheckHandler(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
-	mockService := &MockItemService{}
+	mockHealthService := &MockHealthService{}
+	mockHealthService.On("Check").Return(domain.HealthReport{
+		Status:       domain.HealthStatusDown,
+		Dependencies: []domain.DependencyHealth{{Name: "database", Status: domain.HealthStatusDown}},
+	})
 	deps := Deps{
-		ItemService: mockService,
+		HealthService: mockHealthService,
 	}
 
 	router := NewRouter(deps)
 
-	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/health", nil)
+	for path, status := range map[string]int{
+		"/livez":  http.StatusOK,
+		"/readyz": http.StatusServiceUnavailable,
+		"/health": http.StatusServiceUnavailable,
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
 
-	router.engine.ServeHTTP(w, req)
+		router.engine.ServeHTTP(w, req)
 
-	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Contains(t, w.Body.String(), "ok")
-	assert.Contains(t, w.Body.String(), "Server is running")
+		assert.Equal(t, status, w.Code, path)
+	}
+	mockHealthService.AssertNumberOfCalls(t, "Check", 2)
 }
 
 func TestRouter_ItemHandler_GetByID(t *testing.T) {
@@ -77,7 +205,7 @@ func TestRouter_ItemHandler_GetByID(t *testing.T) {
 		Title: "Test Item",
 	}
 
-	mockService.On("GetEnriched", "test-id").Return(expectedItem, nil)
+	mockService.On("GetEnriched", "test-id", "").Return(expectedItem, nil)
 
 	deps := Deps{
 		ItemService: mockService,
@@ -98,7 +226,7 @@ func TestRouter_ItemHandler_GetByID_Error(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
-	mockService.On("GetEnriched", "invalid-id").Return(nil, assert.AnError)
+	mockService.On("GetEnriched", "invalid-id", "").Return(nil, domain.NewNotFound("item not found"))
 
 	deps := Deps{
 		ItemService: mockService,
@@ -108,17 +236,32 @@ func TestRouter_ItemHandler_GetByID_Error(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/items/invalid-id", nil)
+	req.Header.Set("X-Request-ID", "req-123")
 
 	router.engine.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+	var body dto.ProblemDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, dto.ProblemDTO{
+		Type:     "about:blank",
+		Title:    "Not Found",
+		Status:   http.StatusNotFound,
+		Detail:   "item not found",
+		Instance: "/api/v1/items/invalid-id",
+		TraceID:  "req-123",
+	}, body)
 	mockService.AssertExpectations(t)
 }
 
-func TestRouter_NoRoute(t *testing.T) {
+func TestRouter_ItemHandler_List(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
+	mockService.On("List", mock.Anything).Return(&domain.ItemSummaryPage{}, nil)
+
 	deps := Deps{
 		ItemService: mockService,
 	}
@@ -126,15 +269,221 @@ func TestRouter_NoRoute(t *testing.T) {
 	router := NewRouter(deps)
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/nonexistent", nil)
+	req, _ := http.NewRequest("GET", "/api/v1/items", nil)
 
 	router.engine.ServeHTTP(w, req)
 
-	assert.Equal(t, http.StatusNotFound, w.Code)
-	assert.Contains(t, w.Body.String(), "route not found")
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRouter_SearchHandler_Search(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockSearchService := &MockSearchService{}
+	mockSearchService.On("Search", mock.Anything).Return(&domain.SearchPage{}, nil)
+
+	deps := Deps{
+		ItemService:   &MockItemService{},
+		SearchService: mockSearchService,
+	}
+
+	router := NewRouter(deps)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/search?q=samsung", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSearchService.AssertExpectations(t)
+}
+
+func TestRouter_FamilyHandler_GetItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockFamilyService := &MockFamilyService{}
+	mockFamilyService.On("GetItems", "family-id").Return(&domain.FamilyItems{}, nil)
+
+	deps := Deps{
+		ItemService:   &MockItemService{},
+		FamilyService: mockFamilyService,
+	}
+
+	router := NewRouter(deps)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/families/family-id/items", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockFamilyService.AssertExpectations(t)
+}
+
+func TestRouter_SellerHandler_GetByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockSellerService := &MockSellerService{}
+	mockSellerService.On("GetProfile", domain.SellerProfileQuery{
+		SellerID: "seller-id",
+		Limit:    domain.DefaultPageLimit,
+	}).Return(&domain.SellerProfile{}, nil)
+
+	deps := Deps{
+		ItemService:   &MockItemService{},
+		SellerService: mockSellerService,
+	}
+
+	router := NewRouter(deps)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/sellers/seller-id", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSellerService.AssertExpectations(t)
+}
+
+func TestRouter_InstallmentHandler_GetByItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockInstallmentService := &MockInstallmentService{}
+	mockInstallmentService.On("GetByItem", "item-id", "").Return(&domain.ItemInstallments{}, nil)
+
+	deps := Deps{
+		ItemService:        &MockItemService{},
+		InstallmentService: mockInstallmentService,
+	}
+
+	router := NewRouter(deps)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/items/item-id/installments", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockInstallmentService.AssertExpectations(t)
+}
+
+func TestRouter_QuestionHandler_Routes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockQuestionService := &MockQuestionService{}
+	mockQuestionService.On("ListByItem", mock.Anything).Return(&domain.QuestionPage{}, nil)
+	mockQuestionService.On("Ask", "item-id", "Is it available?").Return(&domain.Question{ID: "question-id"}, nil)
+	mockQuestionService.On("Answer", "question-id", "Yes").Return(&domain.Question{ID: "question-id"}, nil)
+
+	router := NewRouter(Deps{
+		ItemService:     &MockItemService{},
+		QuestionService: mockQuestionService,
+		AdminToken:      "s3cret",
+	})
+
+	testCases := []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"GET", "/api/v1/items/item-id/questions", "", http.StatusOK},
+		{"POST", "/api/v1/items/item-id/questions", `{"question": "Is it available?"}`, http.StatusCreated},
+		{"PUT", "/api/v1/questions/question-id/answer", `{"answer": "Yes"}`, http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer s3cret")
+
+		router.engine.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.status, w.Code, tc.path)
+	}
+	mockQuestionService.AssertExpectations(t)
 }
 
-func TestRouter_CorsMiddleware_Options(t *testing.T) {
+func TestRouter_QuestionHandler_AnswerRequiresToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		adminToken    string
+		authorization string
+	}{
+		{"no token sent", "s3cret", ""},
+		{"wrong token", "s3cret", "Bearer wrong"},
+		{"no admin token configured", "", "Bearer "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQuestionService := &MockQuestionService{}
+			router := NewRouter(Deps{
+				ItemService:     &MockItemService{},
+				QuestionService: mockQuestionService,
+				AdminToken:      tt.adminToken,
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/api/v1/questions/question-id/answer", strings.NewReader(`{"answer": "Yes"}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			router.engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			mockQuestionService.AssertNotCalled(t, "Answer", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRouter_ReviewHandler_Create(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewService := &MockReviewService{}
+	mockReviewService.On("Submit", domain.NewReview{ItemID: "item-id", Rating: 5}).Return(&domain.SubmittedReview{}, nil)
+
+	router := NewRouter(Deps{
+		ItemService:   &MockItemService{},
+		ReviewService: mockReviewService,
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/items/item-id/reviews", strings.NewReader(`{"rating": 5}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockReviewService.AssertExpectations(t)
+}
+
+func TestRouter_ReviewHandler_ListByItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewService := &MockReviewService{}
+	mockReviewService.On("ListByItem", mock.Anything).Return(&domain.ReviewPage{}, nil)
+
+	router := NewRouter(Deps{
+		ItemService:   &MockItemService{},
+		ReviewService: mockReviewService,
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/items/item-id/reviews?sort=lowest", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockReviewService.AssertExpectations(t)
+}
+
+func TestRouter_NoRoute(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
@@ -144,30 +493,163 @@ func TestRouter_CorsMiddleware_Options(t *testing.T) {
 
 	router := NewRouter(deps)
 
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/nonexistent", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "route not found")
+}
+
+func TestRouter_NoMethod(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/items", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "method not allowed")
+}
+
+func TestRouter_CORS_Preflight(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{
+		ItemService: &MockItemService{},
+		CORS: cors.Policy{
+			AllowedOrigins: []string{"https://*.example.com"},
+			MaxAge:         10 * time.Minute,
+		},
+	})
+
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("OPTIONS", "/api/v1/items/test-id", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "GET")
 
 	router.engine.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST, PUT", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type, Authorization, X-Request-ID, traceparent", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
 }
 
-func TestRouter_CorsMiddleware_Get(t *testing.T) {
+func TestRouter_CORS_UnknownOrigin(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	mockService := &MockItemService{}
-	deps := Deps{
+	router := NewRouter(Deps{
 		ItemService: mockService,
-	}
+		CORS:        cors.Policy{AllowedOrigins: []string{"https://app.example.com"}},
+	})
 
-	router := NewRouter(deps)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/items/test-id", nil)
+	req.Header.Set("Origin", "https://evil.com")
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockService.AssertNotCalled(t, "GetEnriched")
+}
+
+func TestRouter_CORS_OptionsWithoutOrigin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("OPTIONS", "/api/v1/items/test-id", nil)
+
+	router.engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestRouter_CORS_NotOnHealth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{
+		CORS: cors.Policy{AllowedOrigins: []string{"*"}},
+	})
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/health", nil)
+	req, _ := http.NewRequest("GET", "/livez", nil)
+	req.Header.Set("Origin", "https://app.example.com")
 
 	router.engine.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
-	assert.Equal(t, "GET, POST, PUT, DELETE, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestRouter_RateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := &MockItemService{}
+	mockService.On("GetEnriched", "test-id", "").Return(&domain.Item{ID: "test-id"}, nil)
+	router := NewRouter(Deps{
+		ItemService: mockService,
+		CORS:        cors.Policy{AllowedOrigins: []string{"https://app.example.com"}},
+		RateLimiter: ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.ClientKey(nil)),
+		RateLimits: RateLimits{
+			API:  ratelimit.Limit{Rate: 1, Burst: 10},
+			Item: ratelimit.Limit{Rate: 1, Burst: 1},
+		},
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		req.RemoteAddr = "203.0.113.7:1234"
+		router.engine.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/api/v1/items/test-id")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", w.Header().Get("X-RateLimit-Limit"))
+
+	// the item limit is exhausted, the API one is not
+	w = get("/api/v1/items/test-id")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	mockService.AssertNumberOfCalls(t, "GetEnriched", 1)
+
+	w = get("/livez")
+	assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestRouter_TrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := NewRouter(Deps{TrustedProxies: []string{"10.0.0.0/8"}})
+
+	var clientIP string
+	router.engine.GET("/ip", func(c *gin.Context) {
+		clientIP = c.ClientIP()
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/ip", nil)
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	req.RemoteAddr = "10.1.2.3:1234"
+	router.engine.ServeHTTP(w, req)
+	assert.Equal(t, "198.51.100.1", clientIP)
+
+	req.RemoteAddr = "203.0.113.7:1234"
+	router.engine.ServeHTTP(w, req)
+	assert.Equal(t, "203.0.113.7", clientIP)
 }
@@ -0,0 +1,238 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	daos "meli-backend/internal/repositories/daos"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Fixtures inserts rows with sensible defaults. Every builder takes
+// functions changing the defaults and inserts the rows the new one
+// references when they are left empty, so a test only spells out what it
+// checks:
+//
+//	item := f.Item(func(i *daos.ItemDAO) { i.Title = "Notebook" })
+//
+// Optional relations, such as the family or the payment group of a product,
+// are left out unless set.
+type Fixtures struct {
+	t  testing.TB
+	db *gorm.DB
+	n  int
+}
+
+func NewFixtures(t testing.TB, db *gorm.DB) *Fixtures {
+	return &Fixtures{t: t, db: db}
+}
+
+func (f *Fixtures) Image(changes ...func(*daos.ImageDAO)) daos.ImageDAO {
+	n := f.next()
+	image := daos.ImageDAO{
+		ID:               uuid.NewString(),
+		URLSmallVersion:  fmt.Sprintf("https://images.test/%d-small.jpg", n),
+		URLMediumVersion: fmt.Sprintf("https://images.test/%d-medium.jpg", n),
+		Alt:              fmt.Sprintf("Image %d", n),
+	}
+	apply(&image, changes)
+	f.insert(&image)
+	return image
+}
+
+func (f *Fixtures) Family(changes ...func(*daos.FamilyDAO)) daos.FamilyDAO {
+	family := daos.FamilyDAO{FamilyID: uuid.NewString(), Title: fmt.Sprintf("Family %d", f.next())}
+	apply(&family, changes)
+	f.insert(&family)
+	return family
+}
+
+func (f *Fixtures) Seller(changes ...func(*daos.SellerDAO)) daos.SellerDAO {
+	seller := daos.SellerDAO{
+		SellerID:              uuid.NewString(),
+		Name:                  fmt.Sprintf("Seller %d", f.next()),
+		Reputation:            "Mercado Lider",
+		NumberOfProducts:      10,
+		NumberOfSales:         100,
+		NumberOfFollowers:     5,
+		CategoryDescription:   "Category Description",
+		GeneralRating:         4.5,
+		AttentionDescription:  "Brinda buena atención",
+		PuntualityDescription: "Entrega sus productos a tiempo",
+	}
+	apply(&seller, changes)
+	f.insert(&seller)
+	return seller
+}
+
+func (f *Fixtures) PaymentGroup() daos.PaymentMethodGroupDAO {
+	group := daos.PaymentMethodGroupDAO{ID: uuid.NewString()}
+	f.insert(&group)
+	return group
+}
+
+// PaymentMethod inserts a method of the payment group groupID. It has no
+// image unless set.
+func (f *Fixtures) PaymentMethod(groupID string, changes ...func(*daos.PaymentMethodDAO)) daos.PaymentMethodDAO {
+	method := daos.PaymentMethodDAO{
+		ID:                   uuid.NewString(),
+		GroupID:              groupID,
+		NumberOfInstallments: 1,
+		Type:                 daos.PaymentTypeCredit,
+	}
+	apply(&method, changes)
+	f.insert(&method)
+	return method
+}
+
+func (f *Fixtures) Product(changes ...func(*daos.ProductDAO)) daos.ProductDAO {
+	n := f.next()
+	product := daos.ProductDAO{
+		ID:            uuid.NewString(),
+		Title:         fmt.Sprintf("Product %d", n),
+		Model:         fmt.Sprintf("MODEL-%d", n),
+		MainSpec:      json.RawMessage(`[{"item": "Color", "value": "Negro", "image_icon_url": "https://icons.test/default.svg"}]`),
+		SecondarySpec: json.RawMessage(`[{"item": "Características generales", "values": [{"item": "Marca", "value": "Acme"}]}]`),
+	}
+	apply(&product, changes)
+	f.insert(&product)
+	return product
+}
+
+// AggregatedReview inserts the review aggregate of the product productID.
+func (f *Fixtures) AggregatedReview(productID string, changes ...func(*daos.AggregatedReviewDAO)) daos.AggregatedReviewDAO {
+	aggregated := daos.AggregatedReviewDAO{ID: uuid.NewString(), ProductID: productID, RatingValue: 4.5, RatingCount: 2}
+	apply(&aggregated, changes)
+	f.insert(&aggregated)
+	return aggregated
+}
+
+func (f *Fixtures) Price(changes ...func(*daos.PriceDAO)) daos.PriceDAO {
+	price := daos.PriceDAO{
+		ID:             uuid.NewString(),
+		Value:          decimal.NewFromInt(1500),
+		CurrencySymbol: "$",
+		CurrencyID:     "ARS",
+	}
+	apply(&price, changes)
+	f.insert(&price)
+	return price
+}
+
+// UserProduct inserts a product sold by a seller, inserting both when their
+// IDs are left empty.
+func (f *Fixtures) UserProduct(changes ...func(*daos.UserProductDAO)) daos.UserProductDAO {
+	userProduct := daos.UserProductDAO{ID: uuid.NewString(), SKU: fmt.Sprintf("SKU%d", f.next())}
+	apply(&userProduct, changes)
+	if userProduct.ProductID == "" {
+		userProduct.ProductID = f.Product().ID
+	}
+	if userProduct.SellerID == "" {
+		userProduct.SellerID = f.Seller().SellerID
+	}
+	f.insert(&userProduct)
+	return userProduct
+}
+
+// Item inserts an item, inserting its user product and price when their
+// IDs are left empty.
+func (f *Fixtures) Item(changes ...func(*daos.ItemDAO)) daos.ItemDAO {
+	item := daos.ItemDAO{
+		ItemID:            uuid.NewString(),
+		Title:             fmt.Sprintf("Item %d", f.next()),
+		Description:       "Brand new",
+		AvailableQuantity: 3,
+		ProductStatus:     string(daos.ProductStatusNew),
+	}
+	apply(&item, changes)
+	if item.UserProductID == "" {
+		item.UserProductID = f.UserProduct().ID
+	}
+	if item.PriceIDFK == "" {
+		item.PriceIDFK = f.Price().ID
+	}
+	f.insert(&item)
+	return item
+}
+
+// ItemImage links an image to the item itemID, inserting the image when its
+// ID is left empty.
+func (f *Fixtures) ItemImage(itemID string, changes ...func(*daos.ItemImageDAO)) daos.ItemImageDAO {
+	itemImage := daos.ItemImageDAO{ID: uuid.NewString(), ItemID: itemID}
+	apply(&itemImage, changes)
+	if itemImage.ImageID == "" {
+		itemImage.ImageID = f.Image().ID
+	}
+	f.insert(&itemImage)
+	return itemImage
+}
+
+// Review inserts a review of item, for its product and seller. Reviews are
+// created a minute apart, in insertion order, unless set.
+func (f *Fixtures) Review(item daos.ItemDAO, changes ...func(*daos.ReviewDAO)) daos.ReviewDAO {
+	var userProduct daos.UserProductDAO
+	require.NoError(f.t, f.db.First(&userProduct, "id = ?", item.UserProductID).Error)
+
+	n := f.next()
+	review := daos.ReviewDAO{
+		ID:        uuid.NewString(),
+		ProductID: userProduct.ProductID,
+		SellerID:  userProduct.SellerID,
+		ItemID:    &item.ItemID,
+		Rating:    5,
+		Content:   fmt.Sprintf("Review %d", n),
+		CreatedAt: f.timestamp(n),
+	}
+	apply(&review, changes)
+	f.insert(&review)
+	return review
+}
+
+// Question inserts an unanswered question about the item itemID. Questions
+// are created a minute apart, in insertion order, unless set.
+func (f *Fixtures) Question(itemID string, changes ...func(*daos.QuestionDAO)) daos.QuestionDAO {
+	n := f.next()
+	question := daos.QuestionDAO{
+		ID:        uuid.NewString(),
+		ItemID:    itemID,
+		Question:  fmt.Sprintf("Question %d?", n),
+		CreatedAt: f.timestamp(n),
+	}
+	apply(&question, changes)
+	omit := []string{clause.Associations}
+	if question.Answer == "" {
+		// stored as NULL, as the questions repository does
+		omit = append(omit, "answer")
+	}
+	require.NoError(f.t, f.db.Omit(omit...).Create(&question).Error)
+	return question
+}
+
+func (f *Fixtures) insert(value interface{}) {
+	f.t.Helper()
+	require.NoError(f.t, f.db.Omit(clause.Associations).Create(value).Error)
+}
+
+// next returns a number unique to the fixtures, to tell defaults apart.
+func (f *Fixtures) next() int {
+	f.n++
+	return f.n
+}
+
+// timestamp returns the n-th minute of a fixed day, as Postgres stores a
+// TIMESTAMP column.
+func (f *Fixtures) timestamp(n int) time.Time {
+	return time.Date(2025, 8, 24, 0, 0, 0, 0, time.UTC).Add(time.Duration(n) * time.Minute)
+}
+
+func apply[T any](value *T, changes []func(*T)) {
+	for _, change := range changes {
+		change(value)
+	}
+}
@@ -0,0 +1,184 @@
+package integration
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	daos "meli-backend/internal/repositories/daos"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsRepository_GetEnriched(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	family := f.Family(func(family *daos.FamilyDAO) { family.Title = "Celulares y Smartphones" })
+	group := f.PaymentGroup()
+	product := f.Product(func(p *daos.ProductDAO) {
+		p.FamilyID = &family.FamilyID
+		p.PaymentGroupID = &group.ID
+	})
+	aggregated := f.AggregatedReview(product.ID, func(a *daos.AggregatedReviewDAO) {
+		a.RatingValue = 4.5
+		a.RatingCount = 2
+	})
+	visa := f.Image(func(image *daos.ImageDAO) { image.Alt = "Visa logo" })
+	credit := f.PaymentMethod(group.ID, func(m *daos.PaymentMethodDAO) {
+		m.NumberOfInstallments = 12
+		m.InterestRatePercentage = 15.5
+		m.ImageID = &visa.ID
+	})
+	transfer := f.PaymentMethod(group.ID, func(m *daos.PaymentMethodDAO) { m.Type = daos.PaymentTypeTransfer })
+	sellerImage := f.Image()
+	seller := f.Seller(func(s *daos.SellerDAO) { s.ImageID = &sellerImage.ID })
+	userProduct := f.UserProduct(func(up *daos.UserProductDAO) {
+		up.ProductID = product.ID
+		up.SellerID = seller.SellerID
+	})
+	price := f.Price(func(p *daos.PriceDAO) { p.Value = decimal.RequireFromString("3137310.50") })
+	item := f.Item(func(i *daos.ItemDAO) {
+		i.UserProductID = userProduct.ID
+		i.PriceIDFK = price.ID
+	})
+	itemImage := f.ItemImage(item.ItemID)
+	older := f.Review(item, func(r *daos.ReviewDAO) { r.Rating = 4 })
+	newer := f.Review(item)
+	question := f.Question(item.ItemID)
+	// a review of another listing of the product counts in its distribution only
+	f.Review(f.Item(func(i *daos.ItemDAO) { i.UserProductID = userProduct.ID }), func(r *daos.ReviewDAO) { r.Rating = 1 })
+
+	got, err := repositories.New(db).GetEnriched(context.Background(), item.ItemID)
+
+	require.NoError(t, err)
+	assert.Equal(t, item.ItemID, got.ID)
+	assert.Equal(t, item.Title, got.Title)
+	assert.Equal(t, "3137310.5", got.Price.Amount.Amount.String())
+	assert.Equal(t, "ARS", got.Price.Amount.Currency)
+
+	gotProduct := got.UserProduct.Product
+	assert.Equal(t, product.ID, gotProduct.ID)
+	assert.Equal(t, domain.Family{ID: family.FamilyID, Title: "Celulares y Smartphones"}, gotProduct.Family)
+	assert.Equal(t, domain.AggregatedReview{ID: aggregated.ID, RatingValue: 4.5, RatingCount: 2}, gotProduct.AggregatedReview)
+	assert.Equal(t, []domain.MainSpecItem{{Item: "Color", Value: "Negro", ImageIconURL: "https://icons.test/default.svg"}}, gotProduct.MainSpec)
+	require.Len(t, gotProduct.SecondarySpec, 1)
+	assert.Equal(t, "Características generales", gotProduct.SecondarySpec[0].Item)
+
+	assert.Equal(t, group.ID, gotProduct.PaymentGroup.ID)
+	require.Len(t, gotProduct.PaymentGroup.PaymentMethods, 2)
+	assert.Equal(t, credit.ID, gotProduct.PaymentGroup.PaymentMethods[0].ID)
+	assert.Equal(t, 15.5, gotProduct.PaymentGroup.PaymentMethods[0].InterestRatePercentage)
+	assert.Equal(t, "Visa logo", gotProduct.PaymentGroup.PaymentMethods[0].Image.Alt)
+	assert.Equal(t, transfer.ID, gotProduct.PaymentGroup.PaymentMethods[1].ID)
+
+	assert.Equal(t, seller.SellerID, got.UserProduct.Seller.ID)
+	assert.Equal(t, sellerImage.ID, got.UserProduct.Seller.Image.ID)
+
+	require.Len(t, got.ItemImages, 1)
+	assert.Equal(t, itemImage.ImageID, got.ItemImages[0].ID)
+	assert.Equal(t, []string{newer.ID, older.ID}, lo.Map(got.Reviews, func(r domain.Review, _ int) string { return r.ID }))
+	assert.Empty(t, got.ReviewsNextCursor)
+	require.Len(t, got.Questions, 1)
+	assert.Equal(t, question.ID, got.Questions[0].ID)
+	assert.Empty(t, got.Questions[0].Answer)
+	assert.Nil(t, got.Questions[0].AnsweredAt)
+
+	counts := lo.SliceToMap(gotProduct.RatingDistribution, func(b domain.RatingBucket) (int, int) { return b.Rating, b.Count })
+	assert.Equal(t, map[int]int{5: 1, 4: 1, 3: 0, 2: 0, 1: 1}, counts)
+}
+
+func TestItemsRepository_GetEnriched_MissingRelations(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	// no family, payment group nor aggregated review, a seller without image
+	// and an item without images, reviews nor questions
+	product := f.Product(func(p *daos.ProductDAO) {
+		p.MainSpec = nil
+		p.SecondarySpec = nil
+	})
+	item := f.Item(func(i *daos.ItemDAO) {
+		i.UserProductID = f.UserProduct(func(up *daos.UserProductDAO) { up.ProductID = product.ID }).ID
+	})
+
+	got, err := repositories.New(db).GetEnriched(context.Background(), item.ItemID)
+
+	require.NoError(t, err)
+	gotProduct := got.UserProduct.Product
+	assert.Equal(t, product.ID, gotProduct.ID)
+	assert.Zero(t, gotProduct.Family)
+	assert.Zero(t, gotProduct.AggregatedReview)
+	assert.Zero(t, gotProduct.PaymentGroup)
+	assert.Empty(t, gotProduct.MainSpec)
+	assert.Zero(t, got.UserProduct.Seller.Image)
+	assert.Empty(t, got.ItemImages)
+	assert.Empty(t, got.Reviews)
+	assert.Empty(t, got.Questions)
+	for _, bucket := range gotProduct.RatingDistribution {
+		assert.Zero(t, bucket.Count)
+	}
+}
+
+func TestItemsRepository_GetEnriched_PaymentMethodWithoutImage(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	group := f.PaymentGroup()
+	method := f.PaymentMethod(group.ID)
+	item := f.Item(func(i *daos.ItemDAO) {
+		product := f.Product(func(p *daos.ProductDAO) { p.PaymentGroupID = &group.ID })
+		i.UserProductID = f.UserProduct(func(up *daos.UserProductDAO) { up.ProductID = product.ID }).ID
+	})
+
+	got, err := repositories.New(db).GetEnriched(context.Background(), item.ItemID)
+
+	require.NoError(t, err)
+	methods := got.UserProduct.Product.PaymentGroup.PaymentMethods
+	require.Len(t, methods, 1)
+	assert.Equal(t, method.ID, methods[0].ID)
+	assert.Zero(t, methods[0].Image)
+}
+
+func TestItemsRepository_GetEnriched_PaginatesEmbeddedReviews(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	item := f.Item()
+	for range domain.DefaultPageLimit + 1 {
+		f.Review(item)
+	}
+
+	got, err := repositories.New(db).GetEnriched(context.Background(), item.ItemID)
+
+	require.NoError(t, err)
+	assert.Len(t, got.Reviews, domain.DefaultPageLimit)
+	assert.NotEmpty(t, got.ReviewsNextCursor)
+}
+
+func TestItemsRepository_GetEnriched_NotFound(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+	f.Item()
+
+	_, err := repositories.New(db).GetEnriched(context.Background(), "00000000-0000-0000-0000-000000000000")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestItemsRepository_GetEnrichedBatch(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	first := f.Item()
+	second := f.Item()
+	missing := "00000000-0000-0000-0000-000000000000"
+
+	got, err := repositories.New(db).GetEnrichedBatch(context.Background(), []string{second.ItemID, missing, first.ItemID, second.ItemID})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{second.ItemID, first.ItemID}, lo.Map(got, func(item *domain.Item, _ int) string { return item.ID }))
+}
@@ -0,0 +1,36 @@
+package integration
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	daos "meli-backend/internal/repositories/daos"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRepository_Search_EscapesHighlights(t *testing.T) {
+	db := NewDB(t)
+	f := NewFixtures(t, db.DB)
+
+	item := f.Item(func(i *daos.ItemDAO) {
+		i.Title = `Celular <script>alert("x")</script> Samsung`
+		i.Description = `<img src=x onerror=alert(1)> Samsung & accesorios`
+	})
+
+	page, err := repositories.NewSearchRepository(db).Search(context.Background(), domain.SearchQuery{Text: "samsung"})
+
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	result := page.Results[0]
+	assert.Equal(t, item.ItemID, result.Item.ID)
+	for _, highlight := range []string{result.TitleHighlight, result.Snippet} {
+		assert.Contains(t, highlight, "<mark>Samsung</mark>")
+		// ts_headline may drop what its parser takes for tags, whatever it
+		// keeps is escaped
+		assert.NotContains(t, strings.NewReplacer("<mark>", "", "</mark>", "").Replace(highlight), "<")
+	}
+}
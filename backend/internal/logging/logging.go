@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Options configures the application logger.
+type Options struct {
+	// Level is one of debug, info, warn or error.
+	Level string
+	// File is the log file path. Logs go to stdout when it is empty.
+	File string
+	// MaxSizeMB is the size at which File is rotated.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files kept next to File.
+	MaxBackups int
+}
+
+// New returns a JSON logger for the given options and the closer of its
+// output, which the caller closes on shutdown.
+func New(opts Options) (*slog.Logger, io.Closer, error) {
+	level, err := ParseLevel(opts.Level)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var out io.WriteCloser = nopCloser{os.Stdout}
+	if opts.File != "" {
+		out, err = OpenRotatingFile(opts.File, int64(opts.MaxSizeMB)*1024*1024, opts.MaxBackups)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return NewWithWriter(out, level), out, nil
+}
+
+// NewWithWriter returns a JSON logger writing to w. Sensitive attributes are
+// redacted, see Redact.
+func NewWithWriter(w io.Writer, level slog.Leveler) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: Redact,
+	}))
+}
+
+// ParseLevel converts a LOG_LEVEL value into a slog level.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown log level %q", level)
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
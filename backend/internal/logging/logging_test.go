@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"debug": slog.LevelDebug,
+		"INFO":  slog.LevelInfo,
+		"":      slog.LevelInfo,
+		"warn":  slog.LevelWarn,
+		"error": slog.LevelError,
+	}
+	for value, expected := range tests {
+		level, err := ParseLevel(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, level, value)
+	}
+
+	_, err := ParseLevel("verbose")
+	assert.Error(t, err)
+}
+
+func TestNewWithWriter_JSONAndLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewWithWriter(&buf, slog.LevelWarn)
+
+	logger.Info("skipped")
+	logger.Warn("kept", "item_id", "MLA1")
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "kept", entry["msg"])
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "MLA1", entry["item_id"])
+}
+
+func TestNewWithWriter_RedactsSensitiveFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewWithWriter(&buf, slog.LevelInfo)
+
+	logger.Info("connecting",
+		"db_password", "secret",
+		slog.Group("headers", "Authorization", "Bearer abc", "Accept", "application/json"),
+	)
+
+	assert.NotContains(t, buf.String(), "secret")
+	assert.NotContains(t, buf.String(), "Bearer abc")
+	assert.Contains(t, buf.String(), `"db_password":"[REDACTED]"`)
+	assert.Contains(t, buf.String(), `"Accept":"application/json"`)
+}
+
+func TestRedactQuery(t *testing.T) {
+	query := map[string][]string{"q": {"samsung"}, "api_key": {"abc"}}
+
+	assert.Equal(t, "api_key=%5BREDACTED%5D&q=samsung", RedactQuery(query))
+	assert.Equal(t, "", RedactQuery(nil))
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, slog.Default(), FromContext(context.Background()))
+
+	logger := NewWithWriter(&bytes.Buffer{}, slog.LevelInfo)
+	ctx := WithLogger(context.Background(), logger)
+
+	assert.Same(t, logger, FromContext(ctx))
+}
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "", RequestID(context.Background()))
+
+	ctx := WithRequestID(context.Background(), "req-1")
+
+	assert.Equal(t, "req-1", RequestID(ctx))
+}
+
+func TestNew_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+
+	logger, closer, err := New(Options{Level: "info", File: path, MaxSizeMB: 1, MaxBackups: 1})
+	assert.NoError(t, err)
+
+	logger.Info("hello")
+	assert.NoError(t, closer.Close())
+	assert.FileExists(t, path)
+}
+
+func TestNew_InvalidLevel(t *testing.T) {
+	_, _, err := New(Options{Level: "verbose"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces the value of sensitive attributes.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are matched case-insensitively against any part of an
+// attribute name, so "db_password" and "Authorization" are both redacted.
+var sensitiveKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"authorization",
+	"cookie",
+	"api_key",
+	"apikey",
+	"dsn",
+	"credential",
+}
+
+// IsSensitive reports whether an attribute, header or query parameter named
+// key must not be logged.
+func IsSensitive(key string) bool {
+	key = strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact is a slog ReplaceAttr function hiding the value of sensitive
+// attributes, including attributes nested in groups.
+func Redact(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Value.Kind() != slog.KindGroup && IsSensitive(attr.Key) {
+		return slog.String(attr.Key, Redacted)
+	}
+	return attr
+}
+
+// RedactQuery returns the encoded query with the values of sensitive
+// parameters replaced.
+func RedactQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	redacted := make(url.Values, len(query))
+	for key, values := range query {
+		if IsSensitive(key) {
+			redacted[key] = []string{Redacted}
+			continue
+		}
+		redacted[key] = values
+	}
+	return redacted.Encode()
+}
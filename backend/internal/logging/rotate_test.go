@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFile_RotatesAtMaxSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	f, err := OpenRotatingFile(path, 10, 2)
+	assert.NoError(t, err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := f.Write([]byte(line))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, f.Close())
+
+	assert.Equal(t, "fourth\n", readFile(t, path))
+	assert.Equal(t, "third\n", readFile(t, path+".1"))
+	assert.Equal(t, "second\n", readFile(t, path+".2"))
+	assert.NoFileExists(t, path+".3")
+}
+
+func TestRotatingFile_AppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	assert.NoError(t, os.WriteFile(path, []byte("old\n"), 0o644))
+
+	f, err := OpenRotatingFile(path, 100, 1)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("new\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	assert.Equal(t, "old\nnew\n", readFile(t, path))
+}
+
+func TestRotatingFile_WithoutBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	f, err := OpenRotatingFile(path, 5, 0)
+	assert.NoError(t, err)
+	f.Write([]byte("first\n"))
+	f.Write([]byte("second\n"))
+	assert.NoError(t, f.Close())
+
+	assert.Equal(t, "second\n", readFile(t, path))
+	assert.NoFileExists(t, path+".1")
+}
+
+func TestRotatingFile_WriteAfterClose(t *testing.T) {
+	f, err := OpenRotatingFile(filepath.Join(t.TempDir(), "app.log"), 10, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	_, err = f.Write([]byte("late\n"))
+
+	assert.ErrorIs(t, err, os.ErrClosed)
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(content)
+}
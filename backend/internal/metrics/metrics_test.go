@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	body, err := io.ReadAll(w.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestStatusClass(t *testing.T) {
+	assert.Equal(t, "2xx", StatusClass(http.StatusOK))
+	assert.Equal(t, "4xx", StatusClass(http.StatusNotFound))
+	assert.Equal(t, "5xx", StatusClass(http.StatusServiceUnavailable))
+	assert.Equal(t, "unknown", StatusClass(0))
+}
+
+func TestMetrics_Observe(t *testing.T) {
+	m := New()
+
+	m.ObserveRequest("GET", "/api/v1/items/:id", http.StatusOK, 20*time.Millisecond)
+	m.ObserveRequest("GET", "/api/v1/items/:id", http.StatusNotFound, time.Millisecond)
+	m.ObserveQuery("items", "GetEnriched", 15*time.Millisecond)
+	m.ObserveCache("items", true)
+	m.ObserveCache("items", false)
+	m.ObserveCache("items", false)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `meli_http_requests_total{method="GET",route="/api/v1/items/:id",status_class="2xx"} 1`)
+	assert.Contains(t, body, `meli_http_requests_total{method="GET",route="/api/v1/items/:id",status_class="4xx"} 1`)
+	assert.Contains(t, body, `meli_http_request_duration_seconds_bucket{method="GET",route="/api/v1/items/:id",status_class="2xx",le="0.025"} 1`)
+	assert.Contains(t, body, `meli_repository_query_duration_seconds_count{operation="GetEnriched",repository="items"} 1`)
+	assert.Contains(t, body, `meli_cache_requests_total{cache="items",result="hit"} 1`)
+	assert.Contains(t, body, `meli_cache_requests_total{cache="items",result="miss"} 2`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestMetrics_RegisterDB(t *testing.T) {
+	db, err := sql.Open("pgx", "postgres://localhost:1/app")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(7)
+
+	m := New()
+	require.NoError(t, m.RegisterDB(db, "app"))
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `go_sql_max_open_connections{db_name="app"} 7`)
+	assert.Contains(t, body, `go_sql_in_use_connections{db_name="app"} 0`)
+	assert.Contains(t, body, `go_sql_wait_count_total{db_name="app"} 0`)
+}
@@ -0,0 +1,443 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/db/seeds"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/service"
+	"os"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The conformance suite holds every implementation of the service repository
+// interfaces to the same behavior. It runs against the seed data, which each
+// test may modify: newRepositories must hand out repositories over a fresh
+// copy of it.
+
+// Identifiers of the seed data.
+const (
+	seedItemID      = "55747713-9cd4-45f7-a4cd-9916ed17a61d"
+	seedSellerID    = "1e1e0031-a2dd-43ab-b8a6-12d62d0a1bec"
+	seedFamilyID    = "d9f950ba-9031-4003-bfaa-613aa09159ae"
+	seedQuestionID  = "7e68f353-f4be-4476-aba0-892bab26d1c3"
+	seedReviewID    = "e95e4b05-f55f-4fdf-80e5-3eb607521442"
+	seedItemImageID = "5a24868b-80fa-40a5-b27d-7deea05b4ad9"
+	seedImageID     = "8a2a296b-e29e-4394-9dba-8004217fbdb0"
+	// missingID is a well formed ID matching no row
+	missingID = "00000000-0000-4000-8000-000000000000"
+)
+
+type repositorySet struct {
+	Items     service.ItemsRepositoryInterface
+	Questions service.QuestionsRepositoryInterface
+	Reviews   service.ReviewsRepositoryInterface
+	Sellers   service.SellersRepositoryInterface
+}
+
+func runConformance(t *testing.T, newRepositories func(t *testing.T) repositorySet) {
+	tests := map[string]func(t *testing.T, repos repositorySet){
+		"Items/GetEnriched":                 testGetEnriched,
+		"Items/GetEnrichedNotFound":         testGetEnrichedNotFound,
+		"Items/List":                        testList,
+		"Items/ListFilters":                 testListFilters,
+		"Items/ListInvalidQuery":            testListInvalidQuery,
+		"Questions/CreateAndList":           testQuestionsCreateAndList,
+		"Questions/Answer":                  testQuestionsAnswer,
+		"Questions/Pagination":              testQuestionsPagination,
+		"Questions/NotFound":                testQuestionsNotFound,
+		"Reviews/Create":                    testReviewsCreate,
+		"Reviews/CreateInvalid":             testReviewsCreateInvalid,
+		"Reviews/ListSorts":                 testReviewsListSorts,
+		"Reviews/Pagination":                testReviewsPagination,
+		"Reviews/EmbeddedInEnrichedItem":    testReviewsEmbeddedInEnrichedItem,
+		"Sellers/GetProfile":                testSellersGetProfile,
+		"Sellers/GetProfileNotFound":        testSellersGetProfileNotFound,
+		"Sellers/GetProfileCountsNewReview": testSellersGetProfileCountsNewReview,
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test(t, newRepositories(t))
+		})
+	}
+}
+
+func testGetEnriched(t *testing.T, repos repositorySet) {
+	item, err := repos.Items.GetEnriched(context.Background(), seedItemID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Celular Samsung Galaxy S24+ 5g 256gb Light Pink", item.Title)
+	assert.Equal(t, 3, item.AvailableQuantity)
+	assert.Equal(t, "New", item.ProductStatus)
+	assert.Equal(t, "3137310", item.Price.Amount.Amount.String())
+	assert.Equal(t, "COP", item.Price.Amount.Currency)
+	assert.Equal(t, "SKU19283894894", item.UserProduct.SKU)
+
+	product := item.UserProduct.Product
+	assert.Equal(t, "SM-S926BZVJLT", product.Model)
+	assert.Len(t, product.MainSpec, 2)
+	assert.Equal(t, "Celulares y Smartphones", product.Family.Title)
+	assert.Equal(t, 5.0, product.AggregatedReview.RatingValue)
+	assert.Equal(t, 3, product.AggregatedReview.RatingCount)
+	require.Len(t, product.PaymentGroup.PaymentMethods, 1)
+	assert.Equal(t, "Visa logo", product.PaymentGroup.PaymentMethods[0].Image.Alt)
+	assert.Equal(t, 1, product.RatingDistribution[0].Count)
+	assert.Equal(t, 100, product.RatingDistribution[0].Percentage)
+
+	assert.Equal(t, "Link Game", item.UserProduct.Seller.Name)
+	assert.Equal(t, "Link Game provider logo", item.UserProduct.Seller.Image.Alt)
+
+	require.Len(t, item.ItemImages, 5)
+	assert.Equal(t, seedItemImageID, item.ItemImages[0].ID)
+	require.Len(t, item.Reviews, 1)
+	assert.Equal(t, seedReviewID, item.Reviews[0].ID)
+	assert.Empty(t, item.ReviewsNextCursor)
+	require.Len(t, item.Questions, 1)
+	assert.Equal(t, seedQuestionID, item.Questions[0].ID)
+}
+
+func testGetEnrichedNotFound(t *testing.T, repos repositorySet) {
+	_, err := repos.Items.GetEnriched(context.Background(), missingID)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testList(t *testing.T, repos repositorySet) {
+	for _, sort := range []domain.ItemSort{"", domain.ItemSortNewest, domain.ItemSortPriceAsc, domain.ItemSortPriceDesc, domain.ItemSortRating} {
+		page, err := repos.Items.List(context.Background(), domain.ItemListQuery{Sort: sort})
+
+		require.NoError(t, err, sort)
+		require.Len(t, page.Items, 1, sort)
+		assert.Empty(t, page.NextCursor, sort)
+
+		summary := page.Items[0]
+		assert.Equal(t, seedItemID, summary.ID)
+		assert.Equal(t, "3137310", summary.Price.Amount.Amount.String())
+		assert.Equal(t, seedImageID, summary.PrimaryImage.ID)
+		assert.Equal(t, 5.0, summary.Rating)
+		assert.Equal(t, 3, summary.RatingCount)
+		assert.False(t, summary.CreatedAt.IsZero())
+	}
+}
+
+func testListFilters(t *testing.T, repos repositorySet) {
+	for name, test := range map[string]struct {
+		filter domain.ItemFilter
+		found  bool
+	}{
+		"family":          {domain.ItemFilter{FamilyID: seedFamilyID}, true},
+		"other family":    {domain.ItemFilter{FamilyID: missingID}, false},
+		"status":          {domain.ItemFilter{Status: "New"}, true},
+		"other status":    {domain.ItemFilter{Status: "Used"}, false},
+		"seller":          {domain.ItemFilter{SellerID: seedSellerID}, true},
+		"other seller":    {domain.ItemFilter{SellerID: missingID}, false},
+		"price range":     {domain.ItemFilter{MinPrice: lo.ToPtr(3137310.0), MaxPrice: lo.ToPtr(3137310.0)}, true},
+		"below min price": {domain.ItemFilter{MinPrice: lo.ToPtr(3137310.01)}, false},
+		"above max price": {domain.ItemFilter{MaxPrice: lo.ToPtr(3137309.99)}, false},
+		"in stock":        {domain.ItemFilter{InStock: true}, true},
+	} {
+		page, err := repos.Items.List(context.Background(), domain.ItemListQuery{Filter: test.filter})
+
+		require.NoError(t, err, name)
+		assert.Equal(t, test.found, len(page.Items) == 1, name)
+	}
+}
+
+func testListInvalidQuery(t *testing.T, repos repositorySet) {
+	_, err := repos.Items.List(context.Background(), domain.ItemListQuery{Sort: "cheapest"})
+	assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+
+	_, err = repos.Items.List(context.Background(), domain.ItemListQuery{Cursor: "not a cursor!"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+
+	// a cursor is only valid for the sort it was issued for
+	cursor := encodeCursor(pageCursor{Sort: string(domain.ItemSortPriceAsc), Key: "10", ID: seedItemID})
+	_, err = repos.Items.List(context.Background(), domain.ItemListQuery{Sort: domain.ItemSortNewest, Cursor: cursor})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+
+	// the seed item is the last one of the listing
+	cursor = encodeCursor(pageCursor{Sort: string(domain.ItemSortPriceAsc), Key: "3137310", ID: seedItemID})
+	page, err := repos.Items.List(context.Background(), domain.ItemListQuery{Sort: domain.ItemSortPriceAsc, Cursor: cursor})
+	require.NoError(t, err)
+	assert.Empty(t, page.Items)
+}
+
+func testQuestionsCreateAndList(t *testing.T, repos repositorySet) {
+	created, err := repos.Questions.Create(context.Background(), seedItemID, "¿Tiene garantía?")
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, seedItemID, created.ItemID)
+	assert.Empty(t, created.Answer)
+	assert.Nil(t, created.AnsweredAt)
+
+	page, err := repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{created.ID, seedQuestionID}, questionIDs(page.Questions))
+
+	page, err = repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID, Status: domain.QuestionStatusAnswered})
+	require.NoError(t, err)
+	assert.Equal(t, []string{seedQuestionID}, questionIDs(page.Questions))
+
+	page, err = repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID, Status: domain.QuestionStatusUnanswered})
+	require.NoError(t, err)
+	assert.Equal(t, []string{created.ID}, questionIDs(page.Questions))
+}
+
+func testQuestionsAnswer(t *testing.T, repos repositorySet) {
+	created, err := repos.Questions.Create(context.Background(), seedItemID, "¿Tiene garantía?")
+	require.NoError(t, err)
+
+	answered, err := repos.Questions.Answer(context.Background(), created.ID, "Sí, 12 meses")
+	require.NoError(t, err)
+	assert.Equal(t, "Sí, 12 meses", answered.Answer)
+	assert.NotNil(t, answered.AnsweredAt)
+
+	_, err = repos.Questions.Answer(context.Background(), created.ID, "again")
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	_, err = repos.Questions.Answer(context.Background(), seedQuestionID, "again")
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	_, err = repos.Questions.Answer(context.Background(), missingID, "answer")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testQuestionsPagination(t *testing.T, repos repositorySet) {
+	for i := 0; i < 4; i++ {
+		_, err := repos.Questions.Create(context.Background(), seedItemID, "question")
+		require.NoError(t, err)
+	}
+
+	all, err := repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID})
+	require.NoError(t, err)
+	require.Len(t, all.Questions, 5)
+
+	var paged []string
+	query := domain.QuestionListQuery{ItemID: seedItemID, Limit: 2}
+	for {
+		page, err := repos.Questions.ListByItem(context.Background(), query)
+		require.NoError(t, err)
+		paged = append(paged, questionIDs(page.Questions)...)
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+	assert.Equal(t, questionIDs(all.Questions), paged)
+}
+
+func testQuestionsNotFound(t *testing.T, repos repositorySet) {
+	_, err := repos.Questions.Create(context.Background(), missingID, "question")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: missingID})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = repos.Questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID, Cursor: "not a cursor!"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func testReviewsCreate(t *testing.T, repos repositorySet) {
+	submitted, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: 3, Content: "Bien"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, submitted.Review.ID)
+	assert.Equal(t, 3, submitted.Review.Rating)
+	assert.Equal(t, "Bien", submitted.Review.Content)
+	// the aggregate is recomputed from the reviews, the seed one was stale
+	assert.Equal(t, 2, submitted.AggregatedReview.RatingCount)
+	assert.Equal(t, 4.0, submitted.AggregatedReview.RatingValue)
+	assert.Contains(t, submitted.ProductItemIDs, seedItemID)
+
+	item, err := repos.Items.GetEnriched(context.Background(), seedItemID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, item.UserProduct.Product.AggregatedReview.RatingCount)
+	assert.Equal(t, submitted.Review.ID, item.Reviews[0].ID)
+}
+
+func testReviewsCreateInvalid(t *testing.T, repos repositorySet) {
+	_, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: missingID, Rating: 5})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: 6})
+	assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+
+	_, err = repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: missingID})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testReviewsListSorts(t *testing.T, repos repositorySet) {
+	for _, rating := range []int{1, 3} {
+		_, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: rating})
+		require.NoError(t, err)
+	}
+
+	for _, scope := range []domain.ReviewScope{domain.ReviewScopeItem, domain.ReviewScopeProduct} {
+		for sort, ratings := range map[domain.ReviewSort][]int{
+			domain.ReviewSortHighest: {5, 3, 1},
+			domain.ReviewSortLowest:  {1, 3, 5},
+		} {
+			page, err := repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Sort: sort, Scope: scope})
+
+			require.NoError(t, err)
+			assert.Equal(t, ratings, reviewRatings(page.Reviews), "%s %s", sort, scope)
+		}
+
+		// the new reviews may share their creation time, only the seed one is older
+		page, err := repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Scope: scope})
+		require.NoError(t, err)
+		require.Len(t, page.Reviews, 3)
+		assert.ElementsMatch(t, []int{1, 3}, reviewRatings(page.Reviews[:2]), scope)
+		assert.Equal(t, seedReviewID, page.Reviews[2].ID, scope)
+	}
+
+	page, err := repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Rating: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, reviewRatings(page.Reviews))
+}
+
+func testReviewsPagination(t *testing.T, repos repositorySet) {
+	for _, rating := range []int{4, 2, 4, 5} {
+		_, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: rating})
+		require.NoError(t, err)
+	}
+
+	for _, sort := range []domain.ReviewSort{domain.ReviewSortNewest, domain.ReviewSortHighest, domain.ReviewSortLowest} {
+		all, err := repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Sort: sort})
+		require.NoError(t, err)
+		require.Len(t, all.Reviews, 5)
+
+		var paged []string
+		query := domain.ReviewListQuery{ItemID: seedItemID, Sort: sort, Limit: 2}
+		for {
+			page, err := repos.Reviews.ListByItem(context.Background(), query)
+			require.NoError(t, err)
+			paged = append(paged, reviewIDs(page.Reviews)...)
+			if page.NextCursor == "" {
+				break
+			}
+			query.Cursor = page.NextCursor
+		}
+		assert.Equal(t, reviewIDs(all.Reviews), paged, sort)
+
+		// cursors are bound to their sort
+		_, err = repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Sort: "other", Cursor: all.NextCursor})
+		if all.NextCursor != "" {
+			assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+		}
+	}
+}
+
+func testReviewsEmbeddedInEnrichedItem(t *testing.T, repos repositorySet) {
+	for i := 0; i < domain.DefaultPageLimit; i++ {
+		_, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: 4})
+		require.NoError(t, err)
+	}
+
+	item, err := repos.Items.GetEnriched(context.Background(), seedItemID)
+	require.NoError(t, err)
+	assert.Len(t, item.Reviews, domain.DefaultPageLimit)
+	require.NotEmpty(t, item.ReviewsNextCursor)
+
+	// the embedded page continues on the reviews listing
+	page, err := repos.Reviews.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: seedItemID, Cursor: item.ReviewsNextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{seedReviewID}, reviewIDs(page.Reviews))
+}
+
+func testSellersGetProfile(t *testing.T, repos repositorySet) {
+	profile, err := repos.Sellers.GetProfile(context.Background(), seedSellerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Link Game", profile.Seller.Name)
+	assert.Equal(t, "Link Game provider logo", profile.Seller.Image.Alt)
+	assert.Equal(t, domain.SellerStats{
+		ProductsCount:    1,
+		ItemsCount:       1,
+		ActiveItemsCount: 1,
+		ReviewsCount:     1,
+		AverageRating:    5,
+	}, profile.Stats)
+	require.Len(t, profile.RatingDistribution, 5)
+	assert.Equal(t, domain.RatingBucket{Rating: 5, Count: 1, Percentage: 100}, profile.RatingDistribution[0])
+}
+
+func testSellersGetProfileNotFound(t *testing.T, repos repositorySet) {
+	_, err := repos.Sellers.GetProfile(context.Background(), missingID)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testSellersGetProfileCountsNewReview(t *testing.T, repos repositorySet) {
+	_, err := repos.Reviews.Create(context.Background(), domain.NewReview{ItemID: seedItemID, Rating: 2})
+	require.NoError(t, err)
+
+	profile, err := repos.Sellers.GetProfile(context.Background(), seedSellerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, profile.Stats.ReviewsCount)
+	assert.Equal(t, 3.5, profile.Stats.AverageRating)
+}
+
+func questionIDs(questions []domain.Question) []string {
+	return lo.Map(questions, func(question domain.Question, _ int) string { return question.ID })
+}
+
+func reviewIDs(reviews []domain.Review) []string {
+	return lo.Map(reviews, func(review domain.Review, _ int) string { return review.ID })
+}
+
+func reviewRatings(reviews []domain.Review) []int {
+	return lo.Map(reviews, func(review domain.Review, _ int) int { return review.Rating })
+}
+
+func TestConformance_Memory(t *testing.T) {
+	runConformance(t, func(t *testing.T) repositorySet {
+		store := NewMemoryStore()
+		require.NoError(t, store.LoadSeeds(seeds.FS))
+		return repositorySet{
+			Items:     NewMemoryItemsRepository(store),
+			Questions: NewMemoryQuestionsRepository(store),
+			Reviews:   NewMemoryReviewsRepository(store),
+			Sellers:   NewMemorySellersRepository(store),
+		}
+	})
+}
+
+// TestConformance_GORM needs a migrated and seeded database in
+// TEST_DATABASE_URL. Every test runs in a transaction rolled back at its end.
+func TestConformance_GORM(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	runConformance(t, func(t *testing.T) repositorySet {
+		tx := db.Begin()
+		require.NoError(t, tx.Error)
+		t.Cleanup(func() { tx.Rollback() })
+
+		wrapper := &DbWrapper{DB: tx}
+		return repositorySet{
+			Items:     New(wrapper),
+			Questions: NewQuestionsRepository(wrapper),
+			Reviews:   NewReviewsRepository(wrapper),
+			Sellers:   NewSellersRepository(wrapper),
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"meli-backend/internal/domain"
+)
+
+// pageCursor is the opaque keyset position handed to clients between pages.
+// Key holds the value of the sort column of the last returned row and ID its
+// primary key, which breaks ties between rows sharing the same key.
+type pageCursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"id"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor returns nil for an empty cursor, meaning "first page".
+func decodeCursor(encoded string, sort string) (*pageCursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	if cursor.Sort != sort || cursor.ID == "" {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
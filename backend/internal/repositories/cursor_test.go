@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	encoded := encodeCursor(pageCursor{Sort: "price_asc", Key: "1500.5", ID: "test-id"})
+
+	cursor, err := decodeCursor(encoded, "price_asc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1500.5", cursor.Key)
+	assert.Equal(t, "test-id", cursor.ID)
+}
+
+func TestDecodeCursor_Empty(t *testing.T) {
+	cursor, err := decodeCursor("", "newest")
+
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestDecodeCursor_Malformed(t *testing.T) {
+	_, err := decodeCursor("not a cursor!", "newest")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestDecodeCursor_SortMismatch(t *testing.T) {
+	encoded := encodeCursor(pageCursor{Sort: "price_asc", Key: "1500.5", ID: "test-id"})
+
+	_, err := decodeCursor(encoded, "rating")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
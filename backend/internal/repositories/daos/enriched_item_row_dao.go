@@ -0,0 +1,131 @@
+package daos
+
+import (
+	"encoding/json"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// EnrichedItemRowDAO is the row returned by the enriched item query: an item
+// joined with its price, user product, product, family, aggregated review,
+// seller and seller image. It is not a table.
+type EnrichedItemRowDAO struct {
+	ItemID            string `gorm:"column:item_id"`
+	UserProductID     string `gorm:"column:user_product_id"`
+	Title             string `gorm:"column:title"`
+	Description       string `gorm:"column:description"`
+	AvailableQuantity int    `gorm:"column:available_quantity"`
+	ProductStatus     string `gorm:"column:product_status"`
+
+	PriceID        string          `gorm:"column:price_id"`
+	PriceValue     decimal.Decimal `gorm:"column:price_value"`
+	CurrencySymbol string          `gorm:"column:currency_symbol"`
+	CurrencyID     string          `gorm:"column:currency_id"`
+
+	SKU string `gorm:"column:sku"`
+
+	ProductID      string          `gorm:"column:product_id"`
+	ProductTitle   string          `gorm:"column:product_title"`
+	ProductModel   string          `gorm:"column:product_model"`
+	MainSpec       json.RawMessage `gorm:"column:main_spec"`
+	SecondarySpec  json.RawMessage `gorm:"column:secondary_spec"`
+	FamilyID       *string         `gorm:"column:family_id"`
+	FamilyTitle    *string         `gorm:"column:family_title"`
+	PaymentGroupID *string         `gorm:"column:payment_group_id"`
+
+	AggregatedReviewID *string  `gorm:"column:aggregated_review_id"`
+	RatingValue        *float64 `gorm:"column:rating_value"`
+	RatingCount        *int     `gorm:"column:rating_count"`
+
+	SellerID              string  `gorm:"column:seller_id"`
+	SellerName            string  `gorm:"column:seller_name"`
+	Reputation            string  `gorm:"column:reputation"`
+	NumberOfProducts      int     `gorm:"column:number_of_products"`
+	NumberOfSales         int     `gorm:"column:number_of_sales"`
+	NumberOfFollowers     int     `gorm:"column:number_of_followers"`
+	CategoryDescription   string  `gorm:"column:category_description"`
+	GeneralRating         float64 `gorm:"column:general_rating"`
+	AttentionDescription  string  `gorm:"column:attention_description"`
+	PuntualityDescription string  `gorm:"column:puntuality_description"`
+
+	SellerImageID               *string `gorm:"column:seller_image_id"`
+	SellerImageURLSmallVersion  *string `gorm:"column:seller_image_url_small_version"`
+	SellerImageURLMediumVersion *string `gorm:"column:seller_image_url_medium_version"`
+	SellerImageAlt              *string `gorm:"column:seller_image_alt"`
+}
+
+// ToItemDAO rebuilds the relations of the row, as preloading them would.
+// Collections and the payment methods of the payment group are loaded apart
+// and left empty.
+func (r *EnrichedItemRowDAO) ToItemDAO() *ItemDAO {
+	product := &ProductDAO{
+		ID:             r.ProductID,
+		Title:          r.ProductTitle,
+		Model:          r.ProductModel,
+		MainSpec:       r.MainSpec,
+		SecondarySpec:  r.SecondarySpec,
+		FamilyID:       r.FamilyID,
+		PaymentGroupID: r.PaymentGroupID,
+	}
+	if r.FamilyID != nil && r.FamilyTitle != nil {
+		product.Family = &FamilyDAO{FamilyID: *r.FamilyID, Title: *r.FamilyTitle}
+	}
+	if r.PaymentGroupID != nil {
+		product.PaymentGroup = &PaymentMethodGroupDAO{ID: *r.PaymentGroupID}
+	}
+	if r.AggregatedReviewID != nil {
+		product.AggregatedReview = &AggregatedReviewDAO{
+			ID:          *r.AggregatedReviewID,
+			ProductID:   r.ProductID,
+			RatingValue: lo.FromPtr(r.RatingValue),
+			RatingCount: lo.FromPtr(r.RatingCount),
+		}
+	}
+
+	seller := &SellerDAO{
+		SellerID:              r.SellerID,
+		Name:                  r.SellerName,
+		Reputation:            r.Reputation,
+		NumberOfProducts:      r.NumberOfProducts,
+		NumberOfSales:         r.NumberOfSales,
+		NumberOfFollowers:     r.NumberOfFollowers,
+		CategoryDescription:   r.CategoryDescription,
+		GeneralRating:         r.GeneralRating,
+		AttentionDescription:  r.AttentionDescription,
+		PuntualityDescription: r.PuntualityDescription,
+		ImageID:               r.SellerImageID,
+	}
+	if r.SellerImageID != nil {
+		seller.Image = &ImageDAO{
+			ID:               *r.SellerImageID,
+			URLSmallVersion:  lo.FromPtr(r.SellerImageURLSmallVersion),
+			URLMediumVersion: lo.FromPtr(r.SellerImageURLMediumVersion),
+			Alt:              lo.FromPtr(r.SellerImageAlt),
+		}
+	}
+
+	return &ItemDAO{
+		ItemID:            r.ItemID,
+		UserProductID:     r.UserProductID,
+		Title:             r.Title,
+		Description:       r.Description,
+		AvailableQuantity: r.AvailableQuantity,
+		ProductStatus:     r.ProductStatus,
+		PriceIDFK:         r.PriceID,
+		Price: &PriceDAO{
+			ID:             r.PriceID,
+			Value:          r.PriceValue,
+			CurrencySymbol: r.CurrencySymbol,
+			CurrencyID:     r.CurrencyID,
+		},
+		UserProduct: &UserProductDAO{
+			ID:        r.UserProductID,
+			ProductID: r.ProductID,
+			SellerID:  r.SellerID,
+			SKU:       r.SKU,
+			Product:   product,
+			Seller:    seller,
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package daos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnrichedItemRowDAO_ToItemDAO_WithAllFields(t *testing.T) {
+	row := &EnrichedItemRowDAO{
+		ItemID:                      "item-1",
+		UserProductID:               "user-product-1",
+		Title:                       "Phone",
+		Description:                 "A phone",
+		AvailableQuantity:           3,
+		ProductStatus:               "New",
+		PriceID:                     "price-1",
+		PriceValue:                  decimal.RequireFromString("1500.50"),
+		CurrencySymbol:              "$",
+		CurrencyID:                  "ARS",
+		SKU:                         "SKU-1",
+		ProductID:                   "product-1",
+		ProductTitle:                "Phone X",
+		ProductModel:                "X",
+		MainSpec:                    json.RawMessage(`[{"item":"Screen","value":"6\""}]`),
+		FamilyID:                    lo.ToPtr("family-1"),
+		FamilyTitle:                 lo.ToPtr("Phones"),
+		PaymentGroupID:              lo.ToPtr("group-1"),
+		AggregatedReviewID:          lo.ToPtr("aggregated-1"),
+		RatingValue:                 lo.ToPtr(4.5),
+		RatingCount:                 lo.ToPtr(12),
+		SellerID:                    "seller-1",
+		SellerName:                  "Store",
+		NumberOfSales:               100,
+		GeneralRating:               4.8,
+		SellerImageID:               lo.ToPtr("image-1"),
+		SellerImageURLSmallVersion:  lo.ToPtr("small.jpg"),
+		SellerImageURLMediumVersion: lo.ToPtr("medium.jpg"),
+		SellerImageAlt:              lo.ToPtr("Logo"),
+	}
+
+	item := row.ToItemDAO().ToDomain()
+
+	assert.Equal(t, "item-1", item.ID)
+	assert.Equal(t, "Phone", item.Title)
+	assert.Equal(t, 3, item.AvailableQuantity)
+	assert.Equal(t, "1500.5", item.Price.Amount.Amount.String())
+	assert.Equal(t, "ARS", item.Price.Amount.Currency)
+	assert.Equal(t, "SKU-1", item.UserProduct.SKU)
+	assert.Equal(t, "Phone X", item.UserProduct.Product.Title)
+	assert.Len(t, item.UserProduct.Product.MainSpec, 1)
+	assert.Equal(t, "Phones", item.UserProduct.Product.Family.Title)
+	assert.Equal(t, "group-1", item.UserProduct.Product.PaymentGroup.ID)
+	assert.Equal(t, 4.5, item.UserProduct.Product.AggregatedReview.RatingValue)
+	assert.Equal(t, 12, item.UserProduct.Product.AggregatedReview.RatingCount)
+	assert.Equal(t, "Store", item.UserProduct.Seller.Name)
+	assert.Equal(t, 100, item.UserProduct.Seller.NumberOfSales)
+	assert.Equal(t, "small.jpg", item.UserProduct.Seller.Image.URLSmallVersion)
+	assert.Empty(t, item.ItemImages)
+	assert.Empty(t, item.Reviews)
+}
+
+func TestEnrichedItemRowDAO_ToItemDAO_WithoutOptionalRelations(t *testing.T) {
+	row := &EnrichedItemRowDAO{
+		ItemID:    "item-1",
+		ProductID: "product-1",
+		SellerID:  "seller-1",
+	}
+
+	dao := row.ToItemDAO()
+
+	assert.Nil(t, dao.UserProduct.Product.Family)
+	assert.Nil(t, dao.UserProduct.Product.PaymentGroup)
+	assert.Nil(t, dao.UserProduct.Product.AggregatedReview)
+	assert.Nil(t, dao.UserProduct.Seller.Image)
+}
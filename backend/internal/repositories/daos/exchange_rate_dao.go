@@ -0,0 +1,29 @@
+package daos
+
+import (
+	"meli-backend/internal/domain"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateDAO represents the exchange_rates table
+type ExchangeRateDAO struct {
+	BaseCurrency  string          `gorm:"primaryKey;column:base_currency"`
+	QuoteCurrency string          `gorm:"primaryKey;column:quote_currency"`
+	Rate          decimal.Decimal `gorm:"type:numeric(24,10);column:rate;not null"`
+	UpdatedAt     time.Time       `gorm:"column:updated_at"`
+}
+
+func (ExchangeRateDAO) TableName() string {
+	return "exchange_rates"
+}
+
+func (e *ExchangeRateDAO) ToDomain() *domain.ExchangeRate {
+	return &domain.ExchangeRate{
+		BaseCurrency:  e.BaseCurrency,
+		QuoteCurrency: e.QuoteCurrency,
+		Rate:          e.Rate,
+		UpdatedAt:     e.UpdatedAt,
+	}
+}
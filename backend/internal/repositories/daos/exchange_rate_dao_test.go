@@ -0,0 +1,33 @@
+package daos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRateDAO_TableName(t *testing.T) {
+	dao := &ExchangeRateDAO{}
+
+	assert.Equal(t, "exchange_rates", dao.TableName())
+}
+
+func TestExchangeRateDAO_ToDomain(t *testing.T) {
+	updatedAt := time.Date(2025, 8, 24, 8, 18, 0, 0, time.UTC)
+	dao := &ExchangeRateDAO{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "COP",
+		Rate:          decimal.RequireFromString("4020.0000000000"),
+		UpdatedAt:     updatedAt,
+	}
+
+	result := dao.ToDomain()
+
+	assert.NotNil(t, result)
+	assert.Equal(t, "USD", result.BaseCurrency)
+	assert.Equal(t, "COP", result.QuoteCurrency)
+	assert.True(t, decimal.NewFromInt(4020).Equal(result.Rate))
+	assert.Equal(t, updatedAt, result.UpdatedAt)
+}
@@ -0,0 +1,33 @@
+package daos
+
+import (
+	"encoding/json"
+	"meli-backend/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+type FamilyVariantsDAO []FamilyVariantDAO
+
+// FamilyVariantDAO is the row returned by the family siblings query. It is not a table.
+type FamilyVariantDAO struct {
+	ItemSummaryDAO `gorm:"embedded"`
+	ProductID      string          `gorm:"column:product_id"`
+	MainSpec       json.RawMessage `gorm:"column:main_spec"`
+}
+
+func (f *FamilyVariantDAO) ToDomain() *domain.FamilyVariant {
+	product := ProductDAO{ID: f.ProductID, MainSpec: f.MainSpec}
+
+	return &domain.FamilyVariant{
+		Item:      *f.ItemSummaryDAO.ToDomain(),
+		ProductID: f.ProductID,
+		MainSpec:  product.mainSpecToDomain(),
+	}
+}
+
+func (f FamilyVariantsDAO) ToDomain() []domain.FamilyVariant {
+	return lo.Map(f, func(item FamilyVariantDAO, _ int) domain.FamilyVariant {
+		return *item.ToDomain()
+	})
+}
@@ -0,0 +1,48 @@
+package daos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFamilyVariantDAO_ToDomain(t *testing.T) {
+	dao := &FamilyVariantDAO{
+		ItemSummaryDAO: ItemSummaryDAO{ItemID: "test-item-id", Title: "Galaxy S24+ 256gb"},
+		ProductID:      "test-product-id",
+		MainSpec:       json.RawMessage(`[{"item": "Color", "value": "Light Pink", "image_icon_url": "icon.svg"}]`),
+	}
+
+	result := dao.ToDomain()
+
+	assert.Equal(t, "test-item-id", result.Item.ID)
+	assert.Equal(t, "test-product-id", result.ProductID)
+	assert.Len(t, result.MainSpec, 1)
+	assert.Equal(t, "Color", result.MainSpec[0].Item)
+	assert.Equal(t, "Light Pink", result.MainSpec[0].Value)
+	assert.Empty(t, result.Attributes)
+}
+
+func TestFamilyVariantDAO_ToDomain_WithEmptyMainSpec(t *testing.T) {
+	dao := &FamilyVariantDAO{
+		ItemSummaryDAO: ItemSummaryDAO{ItemID: "test-item-id"},
+		MainSpec:       json.RawMessage(`[]`),
+	}
+
+	result := dao.ToDomain()
+
+	assert.NotNil(t, result.MainSpec)
+	assert.Len(t, result.MainSpec, 0)
+}
+
+func TestFamilyVariantsDAO_ToDomain(t *testing.T) {
+	daos := FamilyVariantsDAO{
+		{ItemSummaryDAO: ItemSummaryDAO{ItemID: "item-1"}},
+		{ItemSummaryDAO: ItemSummaryDAO{ItemID: "item-2"}},
+	}
+
+	result := daos.ToDomain()
+
+	assert.Len(t, result, 2)
+}
@@ -0,0 +1,57 @@
+package daos
+
+import (
+	"meli-backend/internal/domain"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+type ItemSummariesDAO []ItemSummaryDAO
+
+// ItemSummaryDAO is the row returned by the item listing query. It is not a table.
+type ItemSummaryDAO struct {
+	ItemID                string          `gorm:"column:item_id"`
+	Title                 string          `gorm:"column:title"`
+	ProductStatus         string          `gorm:"column:product_status"`
+	CreatedAt             time.Time       `gorm:"column:created_at"`
+	PriceID               string          `gorm:"column:price_id"`
+	PriceValue            decimal.Decimal `gorm:"column:price_value"`
+	CurrencySymbol        string          `gorm:"column:currency_symbol"`
+	CurrencyID            string          `gorm:"column:currency_id"`
+	ImageID               *string         `gorm:"column:image_id"`
+	ImageURLSmallVersion  *string         `gorm:"column:image_url_small_version"`
+	ImageURLMediumVersion *string         `gorm:"column:image_url_medium_version"`
+	ImageAlt              *string         `gorm:"column:image_alt"`
+	RatingValue           float64         `gorm:"column:rating_value"`
+	RatingCount           int             `gorm:"column:rating_count"`
+}
+
+func (i *ItemSummaryDAO) ToDomain() *domain.ItemSummary {
+	return &domain.ItemSummary{
+		ID:     i.ItemID,
+		Title:  i.Title,
+		Status: i.ProductStatus,
+		Price: domain.Price{
+			ID:             i.PriceID,
+			Amount:         domain.NewMoney(i.PriceValue, i.CurrencyID),
+			CurrencySymbol: i.CurrencySymbol,
+		},
+		PrimaryImage: domain.Image{
+			ID:               lo.FromPtr(i.ImageID),
+			URLSmallVersion:  lo.FromPtr(i.ImageURLSmallVersion),
+			URLMediumVersion: lo.FromPtr(i.ImageURLMediumVersion),
+			Alt:              lo.FromPtr(i.ImageAlt),
+		},
+		Rating:      i.RatingValue,
+		RatingCount: i.RatingCount,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+func (i ItemSummariesDAO) ToDomain() []domain.ItemSummary {
+	return lo.Map(i, func(item ItemSummaryDAO, _ int) domain.ItemSummary {
+		return *item.ToDomain()
+	})
+}
@@ -0,0 +1,69 @@
+package daos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemSummaryDAO_ToDomain_WithAllFields(t *testing.T) {
+	createdAt := time.Date(2025, 8, 24, 8, 18, 0, 0, time.UTC)
+	dao := &ItemSummaryDAO{
+		ItemID:                "test-item-id",
+		Title:                 "Test Item",
+		ProductStatus:         "New",
+		CreatedAt:             createdAt,
+		PriceID:               "test-price-id",
+		PriceValue:            decimal.RequireFromString("1500.50"),
+		CurrencySymbol:        "$",
+		CurrencyID:            "COP",
+		ImageID:               lo.ToPtr("test-image-id"),
+		ImageURLSmallVersion:  lo.ToPtr("small.jpg"),
+		ImageURLMediumVersion: lo.ToPtr("medium.jpg"),
+		ImageAlt:              lo.ToPtr("Front view"),
+		RatingValue:           4.5,
+		RatingCount:           12,
+	}
+
+	result := dao.ToDomain()
+
+	assert.Equal(t, "test-item-id", result.ID)
+	assert.Equal(t, "Test Item", result.Title)
+	assert.Equal(t, "New", result.Status)
+	assert.Equal(t, createdAt, result.CreatedAt)
+	assert.Equal(t, "1500.5", result.Price.Amount.Amount.String())
+	assert.Equal(t, "COP", result.Price.Amount.Currency)
+	assert.Equal(t, "small.jpg", result.PrimaryImage.URLSmallVersion)
+	assert.Equal(t, "medium.jpg", result.PrimaryImage.URLMediumVersion)
+	assert.Equal(t, "Front view", result.PrimaryImage.Alt)
+	assert.Equal(t, 4.5, result.Rating)
+	assert.Equal(t, 12, result.RatingCount)
+}
+
+func TestItemSummaryDAO_ToDomain_WithoutImage(t *testing.T) {
+	dao := &ItemSummaryDAO{
+		ItemID: "test-item-id",
+	}
+
+	result := dao.ToDomain()
+
+	assert.Equal(t, "test-item-id", result.ID)
+	assert.Empty(t, result.PrimaryImage.ID)
+	assert.Empty(t, result.PrimaryImage.URLSmallVersion)
+}
+
+func TestItemSummariesDAO_ToDomain(t *testing.T) {
+	daos := ItemSummariesDAO{
+		{ItemID: "item-1"},
+		{ItemID: "item-2"},
+	}
+
+	result := daos.ToDomain()
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "item-1", result[0].ID)
+	assert.Equal(t, "item-2", result[1].ID)
+}
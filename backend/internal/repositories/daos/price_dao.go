@@ -1,13 +1,17 @@
 package daos
 
-import "meli-backend/internal/domain"
+import (
+	"meli-backend/internal/domain"
+
+	"github.com/shopspring/decimal"
+)
 
 // PriceDAO represents the prices table
 type PriceDAO struct {
-	ID             string  `gorm:"type:uuid;primaryKey;column:id"`
-	Value          float64 `gorm:"type:numeric(18,2);column:value;not null"`
-	CurrencySymbol string  `gorm:"column:currency_symbol"`
-	CurrencyID     string  `gorm:"column:currency_id"`
+	ID             string          `gorm:"type:uuid;primaryKey;column:id"`
+	Value          decimal.Decimal `gorm:"type:numeric(18,2);column:value;not null"`
+	CurrencySymbol string          `gorm:"column:currency_symbol"`
+	CurrencyID     string          `gorm:"column:currency_id"`
 }
 
 func (PriceDAO) TableName() string {
@@ -17,8 +21,7 @@ func (PriceDAO) TableName() string {
 func (p *PriceDAO) ToDomain() *domain.Price {
 	return &domain.Price{
 		ID:             p.ID,
-		Value:          p.Value,
+		Amount:         domain.NewMoney(p.Value, p.CurrencyID),
 		CurrencySymbol: p.CurrencySymbol,
-		CurrencyID:     p.CurrencyID,
 	}
 }
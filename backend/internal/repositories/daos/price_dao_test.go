@@ -3,6 +3,7 @@ package daos
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,7 +17,7 @@ func TestPriceDAO_TableName(t *testing.T) {
 func TestPriceDAO_ToDomain_WithAllFields(t *testing.T) {
 	dao := &PriceDAO{
 		ID:             "test-price-id",
-		Value:          99.99,
+		Value:          decimal.RequireFromString("99.99"),
 		CurrencySymbol: "$",
 		CurrencyID:     "USD",
 	}
@@ -25,15 +26,15 @@ func TestPriceDAO_ToDomain_WithAllFields(t *testing.T) {
 
 	assert.NotNil(t, result)
 	assert.Equal(t, "test-price-id", result.ID)
-	assert.Equal(t, 99.99, result.Value)
+	assert.Equal(t, "99.99", result.Amount.Amount.String())
 	assert.Equal(t, "$", result.CurrencySymbol)
-	assert.Equal(t, "USD", result.CurrencyID)
+	assert.Equal(t, "USD", result.Amount.Currency)
 }
 
 func TestPriceDAO_ToDomain_WithZeroValue(t *testing.T) {
 	dao := &PriceDAO{
 		ID:             "test-price-id",
-		Value:          0.0,
+		Value:          decimal.RequireFromString("0"),
 		CurrencySymbol: "",
 		CurrencyID:     "",
 	}
@@ -42,15 +43,15 @@ func TestPriceDAO_ToDomain_WithZeroValue(t *testing.T) {
 
 	assert.NotNil(t, result)
 	assert.Equal(t, "test-price-id", result.ID)
-	assert.Equal(t, 0.0, result.Value)
+	assert.Equal(t, "0", result.Amount.Amount.String())
 	assert.Equal(t, "", result.CurrencySymbol)
-	assert.Equal(t, "", result.CurrencyID)
+	assert.Equal(t, "", result.Amount.Currency)
 }
 
 func TestPriceDAO_ToDomain_WithNegativeValue(t *testing.T) {
 	dao := &PriceDAO{
 		ID:             "test-price-id",
-		Value:          -50.0,
+		Value:          decimal.RequireFromString("-50"),
 		CurrencySymbol: "€",
 		CurrencyID:     "EUR",
 	}
@@ -59,7 +60,7 @@ func TestPriceDAO_ToDomain_WithNegativeValue(t *testing.T) {
 
 	assert.NotNil(t, result)
 	assert.Equal(t, "test-price-id", result.ID)
-	assert.Equal(t, -50.0, result.Value)
+	assert.Equal(t, "-50", result.Amount.Amount.String())
 	assert.Equal(t, "€", result.CurrencySymbol)
-	assert.Equal(t, "EUR", result.CurrencyID)
+	assert.Equal(t, "EUR", result.Amount.Currency)
 }
@@ -10,11 +10,12 @@ import (
 type QuestionsDAO []QuestionDAO
 
 type QuestionDAO struct {
-	ID        string    `gorm:"type:uuid;primaryKey;column:id"`
-	ItemID    string    `gorm:"type:uuid;column:item_id;not null"`
-	Question  string    `gorm:"column:question;not null"`
-	Answer    string    `gorm:"column:answer"`
-	CreatedAt time.Time `gorm:"column:created_at;default:now()"`
+	ID         string     `gorm:"type:uuid;primaryKey;column:id"`
+	ItemID     string     `gorm:"type:uuid;column:item_id;not null"`
+	Question   string     `gorm:"column:question;not null"`
+	Answer     string     `gorm:"column:answer"`
+	CreatedAt  time.Time  `gorm:"column:created_at;default:now()"`
+	AnsweredAt *time.Time `gorm:"column:answered_at"`
 }
 
 func (QuestionDAO) TableName() string {
@@ -23,10 +24,12 @@ func (QuestionDAO) TableName() string {
 
 func (q *QuestionDAO) ToDomain() *domain.Question {
 	return &domain.Question{
-		ID:        q.ID,
-		Question:  q.Question,
-		Answer:    q.Answer,
-		CreatedAt: q.CreatedAt,
+		ID:         q.ID,
+		ItemID:     q.ItemID,
+		Question:   q.Question,
+		Answer:     q.Answer,
+		CreatedAt:  q.CreatedAt,
+		AnsweredAt: q.AnsweredAt,
 	}
 }
 
@@ -31,6 +31,8 @@ func TestQuestionDAO_ToDomain_WithAllFields(t *testing.T) {
 	assert.Equal(t, "What is the warranty period for this product?", result.Question)
 	assert.Equal(t, "This product comes with a 2-year warranty.", result.Answer)
 	assert.Equal(t, createdAt, result.CreatedAt)
+	assert.Equal(t, "test-item-id", result.ItemID)
+	assert.Nil(t, result.AnsweredAt)
 }
 
 func TestQuestionDAO_ToDomain_WithEmptyAnswer(t *testing.T) {
@@ -110,3 +112,22 @@ func TestQuestionsDAO_ToDomain_Empty(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Len(t, result, 0)
 }
+
+func TestQuestionDAO_ToDomain_WithAnsweredAt(t *testing.T) {
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	answeredAt := createdAt.Add(2 * time.Hour)
+	dao := &QuestionDAO{
+		ID:         "test-question-id",
+		ItemID:     "test-item-id",
+		Question:   "Does it include a charger?",
+		Answer:     "Yes, it does.",
+		CreatedAt:  createdAt,
+		AnsweredAt: &answeredAt,
+	}
+
+	result := dao.ToDomain()
+
+	assert.NotNil(t, result.AnsweredAt)
+	assert.Equal(t, answeredAt, *result.AnsweredAt)
+	assert.True(t, result.IsAnswered())
+}
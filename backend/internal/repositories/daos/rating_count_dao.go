@@ -0,0 +1,39 @@
+package daos
+
+// RatingCountsDAO is the result of counting reviews grouped by rating. It is not a table.
+type RatingCountsDAO []RatingCountDAO
+
+type RatingCountDAO struct {
+	Rating int `gorm:"column:rating"`
+	Count  int `gorm:"column:count"`
+}
+
+func (r RatingCountsDAO) ToMap() map[int]int {
+	counts := make(map[int]int, len(r))
+	for _, row := range r {
+		counts[row.Rating] = row.Count
+	}
+	return counts
+}
+
+// ProductRatingCountsDAO is the result of counting the reviews of several
+// products grouped by product and rating. It is not a table.
+type ProductRatingCountsDAO []ProductRatingCountDAO
+
+type ProductRatingCountDAO struct {
+	ProductID string `gorm:"column:product_id"`
+	Rating    int    `gorm:"column:rating"`
+	Count     int    `gorm:"column:count"`
+}
+
+// ToMaps returns the counts per rating of every product.
+func (r ProductRatingCountsDAO) ToMaps() map[string]map[int]int {
+	counts := map[string]map[int]int{}
+	for _, row := range r {
+		if counts[row.ProductID] == nil {
+			counts[row.ProductID] = map[int]int{}
+		}
+		counts[row.ProductID][row.Rating] = row.Count
+	}
+	return counts
+}
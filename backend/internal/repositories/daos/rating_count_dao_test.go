@@ -0,0 +1,33 @@
+package daos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingCountsDAO_ToMap(t *testing.T) {
+	daos := RatingCountsDAO{
+		{Rating: 5, Count: 10},
+		{Rating: 1, Count: 2},
+	}
+
+	result := daos.ToMap()
+
+	assert.Equal(t, map[int]int{5: 10, 1: 2}, result)
+}
+
+func TestProductRatingCountsDAO_ToMaps(t *testing.T) {
+	daos := ProductRatingCountsDAO{
+		{ProductID: "product-1", Rating: 5, Count: 10},
+		{ProductID: "product-1", Rating: 1, Count: 2},
+		{ProductID: "product-2", Rating: 4, Count: 3},
+	}
+
+	result := daos.ToMaps()
+
+	assert.Equal(t, map[string]map[int]int{
+		"product-1": {5: 10, 1: 2},
+		"product-2": {4: 3},
+	}, result)
+}
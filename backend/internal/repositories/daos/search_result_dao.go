@@ -0,0 +1,52 @@
+package daos
+
+import (
+	"html"
+	"meli-backend/internal/domain"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// HighlightStart and HighlightStop delimit the matched terms in the
+// ts_headline output. They are private use characters rather than <mark>
+// tags, so the headline can be HTML-escaped before the tags are put in.
+const (
+	HighlightStart = "\uE000"
+	HighlightStop  = "\uE001"
+)
+
+var highlightTags = strings.NewReplacer(HighlightStart, "<mark>", HighlightStop, "</mark>")
+
+type SearchResultsDAO []SearchResultDAO
+
+// SearchResultDAO is the row returned by the full-text search query. It is not a table.
+// TitleHighlight and Snippet are plain text with the matched terms between
+// HighlightStart and HighlightStop.
+type SearchResultDAO struct {
+	ItemSummaryDAO `gorm:"embedded"`
+	Rank           float64 `gorm:"column:rank"`
+	TitleHighlight string  `gorm:"column:title_highlight"`
+	Snippet        string  `gorm:"column:snippet"`
+}
+
+func (s *SearchResultDAO) ToDomain() *domain.SearchResult {
+	return &domain.SearchResult{
+		Item:           *s.ItemSummaryDAO.ToDomain(),
+		Rank:           s.Rank,
+		TitleHighlight: highlightHTML(s.TitleHighlight),
+		Snippet:        highlightHTML(s.Snippet),
+	}
+}
+
+func (s SearchResultsDAO) ToDomain() []domain.SearchResult {
+	return lo.Map(s, func(item SearchResultDAO, _ int) domain.SearchResult {
+		return *item.ToDomain()
+	})
+}
+
+// highlightHTML escapes a headline and wraps its matched terms in <mark>,
+// which is then the only markup: titles and descriptions are seller input.
+func highlightHTML(headline string) string {
+	return highlightTags.Replace(html.EscapeString(headline))
+}
@@ -0,0 +1,53 @@
+package daos
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchResultDAO_ToDomain(t *testing.T) {
+	dao := &SearchResultDAO{
+		ItemSummaryDAO: ItemSummaryDAO{
+			ItemID:     "test-item-id",
+			Title:      "Celular Samsung Galaxy S24+ 256gb",
+			PriceValue: decimal.NewFromInt(3137310),
+		},
+		Rank:           0.75,
+		TitleHighlight: "Celular " + HighlightStart + "Samsung" + HighlightStop + " Galaxy S24+ " + HighlightStart + "256gb" + HighlightStop,
+		Snippet:        "Pantalla de 6,7",
+	}
+
+	result := dao.ToDomain()
+
+	assert.Equal(t, "test-item-id", result.Item.ID)
+	assert.Equal(t, "3137310", result.Item.Price.Amount.Amount.String())
+	assert.Equal(t, 0.75, result.Rank)
+	assert.Equal(t, "Celular <mark>Samsung</mark> Galaxy S24+ <mark>256gb</mark>", result.TitleHighlight)
+	assert.Equal(t, "Pantalla de 6,7", result.Snippet)
+}
+
+func TestSearchResultDAO_ToDomain_EscapesSellerMarkup(t *testing.T) {
+	dao := &SearchResultDAO{
+		TitleHighlight: `<script>alert("x")</script> ` + HighlightStart + "Samsung" + HighlightStop + " & Co",
+		Snippet:        `<img src=x onerror='alert(1)'> ` + HighlightStart + "<b>Galaxy</b>" + HighlightStop,
+	}
+
+	result := dao.ToDomain()
+
+	assert.Equal(t, "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; <mark>Samsung</mark> &amp; Co", result.TitleHighlight)
+	assert.Equal(t, "&lt;img src=x onerror=&#39;alert(1)&#39;&gt; <mark>&lt;b&gt;Galaxy&lt;/b&gt;</mark>", result.Snippet)
+}
+
+func TestSearchResultsDAO_ToDomain(t *testing.T) {
+	daos := SearchResultsDAO{
+		{ItemSummaryDAO: ItemSummaryDAO{ItemID: "item-1"}},
+		{ItemSummaryDAO: ItemSummaryDAO{ItemID: "item-2"}},
+	}
+
+	result := daos.ToDomain()
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "item-2", result[1].Item.ID)
+}
@@ -0,0 +1,22 @@
+package daos
+
+import "meli-backend/internal/domain"
+
+// SellerStatsDAO is the row returned by the seller counters query. It is not a table.
+type SellerStatsDAO struct {
+	ProductsCount    int     `gorm:"column:products_count"`
+	ItemsCount       int     `gorm:"column:items_count"`
+	ActiveItemsCount int     `gorm:"column:active_items_count"`
+	ReviewsCount     int     `gorm:"column:reviews_count"`
+	RatingValue      float64 `gorm:"column:rating_value"`
+}
+
+func (s *SellerStatsDAO) ToDomain() *domain.SellerStats {
+	return &domain.SellerStats{
+		ProductsCount:    s.ProductsCount,
+		ItemsCount:       s.ItemsCount,
+		ActiveItemsCount: s.ActiveItemsCount,
+		ReviewsCount:     s.ReviewsCount,
+		AverageRating:    s.RatingValue,
+	}
+}
@@ -0,0 +1,26 @@
+package daos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSellerStatsDAO_ToDomain(t *testing.T) {
+	dao := &SellerStatsDAO{
+		ProductsCount:    3,
+		ItemsCount:       5,
+		ActiveItemsCount: 4,
+		ReviewsCount:     12,
+		RatingValue:      4.25,
+	}
+
+	result := dao.ToDomain()
+
+	assert.NotNil(t, result)
+	assert.Equal(t, 3, result.ProductsCount)
+	assert.Equal(t, 5, result.ItemsCount)
+	assert.Equal(t, 4, result.ActiveItemsCount)
+	assert.Equal(t, 12, result.ReviewsCount)
+	assert.Equal(t, 4.25, result.AverageRating)
+}
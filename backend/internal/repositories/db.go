@@ -1,8 +1,12 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"meli-backend/internal/tracing"
+	"strings"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -10,24 +14,87 @@ import (
 
 type DbWrapper struct {
 	DB *gorm.DB
+	// Observer, when set, records the duration of every repository
+	// operation.
+	Observer QueryObserver
 }
 
-func NewDbWrapper(host, user, password, dbname, port string) (*DbWrapper, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
-		host, user, password, dbname, port,
+// QueryObserver records how long repository operations take.
+type QueryObserver interface {
+	ObserveQuery(repository, operation string, duration time.Duration)
+}
+
+// startOperation starts the span of a repository operation, parent of the
+// spans of its SQL statements. The returned function ends it and records the
+// duration; it is meant to be deferred at the top of repository methods.
+func (d *DbWrapper) startOperation(ctx context.Context, repository, operation string) (context.Context, func()) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, repository+"."+operation)
+	return ctx, func() {
+		span.End()
+		if d.Observer != nil {
+			d.Observer.ObserveQuery(repository, operation, time.Since(start))
+		}
+	}
+}
+
+// DbConfig holds the connection and pool settings of the database.
+type DbConfig struct {
+	Host            string
+	User            string
+	Password        string
+	Name            string
+	Port            string
+	SSLMode         string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// DSN returns the postgres connection string. Values are quoted so passwords
+// with spaces or quotes are passed through unchanged.
+func (c DbConfig) DSN() string {
+	sslMode := c.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		dsnValue(c.Host), dsnValue(c.User), dsnValue(c.Password), dsnValue(c.Name), dsnValue(c.Port), sslMode,
 	)
+}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func dsnValue(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// NewDbWrapper opens the database and checks it is reachable. ctx bounds the
+// connection check, so callers can give each attempt its own timeout.
+func NewDbWrapper(ctx context.Context, cfg DbConfig) (*DbWrapper, error) {
+	dsn := cfg.DSN()
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger:               newDbLogger(),
+		DisableAutomaticPing: true,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
+	if err := db.Use(tracingPlugin{}); err != nil {
+		return nil, fmt.Errorf("failed to install tracing: %w", err)
+	}
 
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
 	}
 
-	if err := configurePool(sqlDB); err != nil {
+	if err := sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if err := configurePool(sqlDB, cfg); err != nil {
+		sqlDB.Close()
 		return nil, fmt.Errorf("failed to configure connection pool: %w", err)
 	}
 
@@ -35,9 +102,14 @@ func NewDbWrapper(host, user, password, dbname, port string) (*DbWrapper, error)
 }
 
 // configurePool configures the database connection pool
-func configurePool(sqlDB *sql.DB) error {
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
+func configurePool(sqlDB *sql.DB, cfg DbConfig) error {
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 	return nil
 }
 
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"meli-backend/internal/logging"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const slowQueryThreshold = 200 * time.Millisecond
+
+// dbLogger sends GORM logs to the request logger of the query context, so
+// every query is tagged with the request ID. Queries are logged at debug
+// level, slow ones at warn and failed ones at error. Bound parameters are
+// never logged, only the placeholders.
+type dbLogger struct {
+	level gormlogger.LogLevel
+}
+
+func newDbLogger() *dbLogger {
+	return &dbLogger{level: gormlogger.Info}
+}
+
+func (l *dbLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return &dbLogger{level: level}
+}
+
+func (l *dbLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		logging.FromContext(ctx).Info(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *dbLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		logging.FromContext(ctx).Warn(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *dbLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		logging.FromContext(ctx).Error(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *dbLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	logger := logging.FromContext(ctx)
+	sql, rows := fc()
+	attrs := []any{"sql", sql, "rows", rows, "duration_ms", elapsed.Milliseconds()}
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gormlogger.Error:
+		logger.Error("query failed", append(attrs, "error", err)...)
+	case elapsed > slowQueryThreshold && l.level >= gormlogger.Warn:
+		logger.Warn("slow query", attrs...)
+	case l.level >= gormlogger.Info:
+		logger.Debug("query", attrs...)
+	}
+}
+
+// ParamsFilter drops the bound parameters from logged queries.
+func (l *dbLogger) ParamsFilter(_ context.Context, sql string, _ ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
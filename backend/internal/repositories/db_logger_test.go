@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"meli-backend/internal/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func newLoggedContext(buf *bytes.Buffer) context.Context {
+	logger := logging.NewWithWriter(buf, slog.LevelDebug).With("request_id", "req-1")
+	return logging.WithLogger(context.Background(), logger)
+}
+
+func query() (string, int64) {
+	return `SELECT * FROM "items" WHERE item_id = $1`, 1
+}
+
+func TestDbLogger_Trace_UsesRequestLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	newDbLogger().Trace(newLoggedContext(&buf), time.Now(), query, nil)
+
+	assert.Contains(t, buf.String(), `"level":"DEBUG"`)
+	assert.Contains(t, buf.String(), `"request_id":"req-1"`)
+	assert.Contains(t, buf.String(), `item_id = $1`)
+}
+
+func TestDbLogger_Trace_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := newLoggedContext(&buf)
+
+	newDbLogger().Trace(ctx, time.Now(), query, gorm.ErrRecordNotFound)
+	assert.NotContains(t, buf.String(), "query failed")
+
+	newDbLogger().Trace(ctx, time.Now(), query, errors.New("connection refused"))
+	assert.Contains(t, buf.String(), `"msg":"query failed"`)
+	assert.Contains(t, buf.String(), `"error":"connection refused"`)
+}
+
+func TestDbLogger_Trace_SlowQuery(t *testing.T) {
+	var buf bytes.Buffer
+
+	newDbLogger().Trace(newLoggedContext(&buf), time.Now().Add(-time.Second), query, nil)
+
+	assert.Contains(t, buf.String(), `"msg":"slow query"`)
+}
+
+func TestDbLogger_Trace_Silent(t *testing.T) {
+	var buf bytes.Buffer
+
+	newDbLogger().LogMode(gormlogger.Silent).Trace(newLoggedContext(&buf), time.Now(), query, errors.New("boom"))
+
+	assert.Empty(t, buf.String())
+}
+
+func TestDbLogger_ParamsFilter_DropsParams(t *testing.T) {
+	sql, params := newDbLogger().ParamsFilter(context.Background(), "SELECT $1", "secret")
+
+	assert.Equal(t, "SELECT $1", sql)
+	assert.Nil(t, params)
+}
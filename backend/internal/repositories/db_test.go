@@ -1,31 +1,58 @@
 package repositories
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewDbWrapper_WithValidParams(t *testing.T) {
-	host := "localhost"
-	user := "testuser"
-	password := "testpass"
-	dbname := "testdb"
-	port := "5432"
+	cfg := DbConfig{
+		Host:     "localhost",
+		User:     "testuser",
+		Password: "testpass",
+		Name:     "testdb",
+		Port:     "5432",
+	}
 
-	_, err := NewDbWrapper(host, user, password, dbname, port)
+	_, err := NewDbWrapper(context.Background(), cfg)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to connect to database")
 }
 
 func TestNewDbWrapper_WithEmptyParams(t *testing.T) {
-	_, err := NewDbWrapper("", "", "", "", "")
+	_, err := NewDbWrapper(context.Background(), DbConfig{})
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to connect to database")
 }
 
+func TestNewDbWrapper_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewDbWrapper(ctx, DbConfig{Host: "localhost", Port: "5432"})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDbConfig_DSN(t *testing.T) {
+	cfg := DbConfig{
+		Host:     "db",
+		User:     "postgres",
+		Password: `p@ss w'rd\`,
+		Name:     "meli_db",
+		Port:     "5432",
+		SSLMode:  "require",
+	}
+
+	assert.Equal(t, `host='db' user='postgres' password='p@ss w\'rd\\' dbname='meli_db' port='5432' sslmode=require`, cfg.DSN())
+	assert.Contains(t, DbConfig{}.DSN(), "sslmode=disable")
+}
+
 func TestDbWrapper_Preload(t *testing.T) {
 	wrapper := &DbWrapper{}
 
@@ -46,3 +73,25 @@ func TestDbWrapper_HealthCheck_WithNilDB(t *testing.T) {
 		wrapper.HealthCheck()
 	})
 }
+
+type recordingObserver struct {
+	operations []string
+}
+
+func (o *recordingObserver) ObserveQuery(repository, operation string, duration time.Duration) {
+	o.operations = append(o.operations, repository+"."+operation)
+}
+
+func TestDbWrapper_StartOperation(t *testing.T) {
+	observer := &recordingObserver{}
+	wrapper := &DbWrapper{Observer: observer}
+
+	_, end := wrapper.startOperation(context.Background(), "items", "GetEnriched")
+	end()
+
+	assert.Equal(t, []string{"items.GetEnriched"}, observer.operations)
+	assert.NotPanics(t, func() {
+		_, end := (&DbWrapper{}).startOperation(context.Background(), "items", "GetEnriched")
+		end()
+	})
+}
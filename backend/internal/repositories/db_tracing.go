@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"errors"
+	"meli-backend/internal/tracing"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "otel:span"
+
+// tracingPlugin is a GORM plugin creating a client span per SQL statement,
+// child of the span in the statement context. Preloads run as statements of
+// their own, so each preloaded association gets its span. As in dbLogger,
+// bound parameters are not recorded.
+type tracingPlugin struct{}
+
+func (tracingPlugin) Name() string {
+	return "otel:tracing"
+}
+
+func (p tracingPlugin) Initialize(db *gorm.DB) error {
+	errs := []error{
+		db.Callback().Create().Before("gorm:create").Register("otel:before_create", p.before),
+		db.Callback().Create().After("gorm:create").Register("otel:after_create", p.after),
+		db.Callback().Query().Before("gorm:query").Register("otel:before_query", p.before),
+		db.Callback().Query().After("gorm:query").Register("otel:after_query", p.after),
+		db.Callback().Update().Before("gorm:update").Register("otel:before_update", p.before),
+		db.Callback().Update().After("gorm:update").Register("otel:after_update", p.after),
+		db.Callback().Delete().Before("gorm:delete").Register("otel:before_delete", p.before),
+		db.Callback().Delete().After("gorm:delete").Register("otel:after_delete", p.after),
+		db.Callback().Row().Before("gorm:row").Register("otel:before_row", p.before),
+		db.Callback().Row().After("gorm:row").Register("otel:after_row", p.after),
+		db.Callback().Raw().Before("gorm:raw").Register("otel:before_raw", p.before),
+		db.Callback().Raw().After("gorm:raw").Register("otel:after_raw", p.after),
+	}
+	return errors.Join(errs...)
+}
+
+func (tracingPlugin) before(db *gorm.DB) {
+	_, span := tracing.Tracer().Start(db.Statement.Context, "db.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+	db.InstanceSet(spanKey, span)
+}
+
+func (tracingPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := value.(trace.Span)
+	defer span.End()
+
+	statement := db.Statement.SQL.String()
+	operation, _, _ := strings.Cut(strings.TrimSpace(statement), " ")
+	operation = strings.ToUpper(operation)
+	if db.Statement.Table != "" {
+		span.SetName(operation + " " + db.Statement.Table)
+	} else if operation != "" {
+		span.SetName(operation)
+	}
+
+	span.SetAttributes(
+		semconv.DBQueryText(statement),
+		semconv.DBOperationName(operation),
+		semconv.DBCollectionName(db.Statement.Table),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	if err := db.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
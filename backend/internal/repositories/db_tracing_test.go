@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newTracedDryRunDB(t *testing.T) (*DbWrapper, *tracetest.SpanRecorder) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(tracingPlugin{}))
+	return &DbWrapper{DB: db}, recorder
+}
+
+func TestTracingPlugin_SpanPerStatement(t *testing.T) {
+	wrapper, recorder := newTracedDryRunDB(t)
+
+	ctx, end := wrapper.startOperation(context.Background(), "items", "GetEnriched")
+	var rows []map[string]interface{}
+	wrapper.DB.WithContext(ctx).Table("items").Where("id = ?", "MLA-secret").Find(&rows)
+	end()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	query, operation := spans[0], spans[1]
+	assert.Equal(t, "SELECT items", query.Name())
+	assert.Equal(t, "items.GetEnriched", operation.Name())
+	assert.Equal(t, operation.SpanContext().SpanID(), query.Parent().SpanID())
+
+	attributes := attribute.NewSet(query.Attributes()...)
+	text, _ := attributes.Value("db.query.text")
+	assert.Equal(t, `SELECT * FROM "items" WHERE id = $1`, text.AsString())
+	assert.NotContains(t, text.AsString(), "MLA-secret")
+	system, _ := attributes.Value("db.system")
+	assert.Equal(t, "postgresql", system.AsString())
+}
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// enrichedItemColumns are the columns scanned into daos.EnrichedItemRowDAO.
+const enrichedItemColumns = `i.item_id, i.user_product_id, i.title, i.description,
+	i.available_quantity, i.product_status,
+	p.id AS price_id, p.value AS price_value, p.currency_symbol, p.currency_id,
+	up.sku,
+	pr.id AS product_id, pr.title AS product_title, pr.model AS product_model,
+	pr.main_spec, pr.secondary_spec, pr.family_id, f.title AS family_title, pr.payment_group_id,
+	ar.id AS aggregated_review_id, ar.rating_value, ar.rating_count,
+	s.seller_id, s.name AS seller_name, s.reputation, s.number_of_products,
+	s.number_of_sales, s.number_of_followers, s.category_description, s.general_rating,
+	s.attention_description, s.puntuality_description,
+	si.id AS seller_image_id, si.url_small_version AS seller_image_url_small_version,
+	si.url_medium_version AS seller_image_url_medium_version, si.alt AS seller_image_alt`
+
+// embeddedReviewsQuery keeps the first page of reviews of every item, newest
+// first, plus one row to tell whether there is a next page.
+const embeddedReviewsQuery = `SELECT id, product_id, seller_id, item_id, rating, content, created_at
+	FROM (
+		SELECT r.*, ROW_NUMBER() OVER (PARTITION BY r.item_id ORDER BY r.created_at DESC, r.id DESC) AS position
+		FROM reviews r
+		WHERE r.item_id IN ?
+	) ranked
+	WHERE position <= ?
+	ORDER BY item_id, created_at DESC, id DESC`
+
+// loadEnrichedItems loads the enriched items with the given IDs in a fixed
+// number of queries, whatever the number of items: one joining the
+// one-to-one chain of every item, then one per collection (payment methods,
+// images, reviews, questions and rating counts) for all of them at once.
+// Items are returned in the order of ids; missing ones are left out.
+func loadEnrichedItems(db *gorm.DB, ids []string) ([]*domain.Item, error) {
+	ids = lo.Uniq(ids)
+
+	var rows []daos.EnrichedItemRowDAO
+	err := db.
+		Table("items i").
+		Select(enrichedItemColumns).
+		Joins("JOIN prices p ON p.id = i.price_id_fk").
+		Joins("JOIN user_products up ON up.id = i.user_product_id").
+		Joins("JOIN products pr ON pr.id = up.product_id").
+		Joins("LEFT JOIN families f ON f.family_id = pr.family_id").
+		Joins("LEFT JOIN aggregated_reviews ar ON ar.product_id = pr.id").
+		Joins("JOIN sellers s ON s.seller_id = up.seller_id").
+		Joins("LEFT JOIN images si ON si.id = s.image_id").
+		Where("i.item_id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	itemIDs := lo.Map(rows, func(row daos.EnrichedItemRowDAO, _ int) string { return row.ItemID })
+	productIDs := lo.Uniq(lo.Map(rows, func(row daos.EnrichedItemRowDAO, _ int) string { return row.ProductID }))
+	groupIDs := lo.Uniq(lo.FilterMap(rows, func(row daos.EnrichedItemRowDAO, _ int) (string, bool) {
+		return lo.FromPtr(row.PaymentGroupID), row.PaymentGroupID != nil
+	}))
+
+	var paymentMethods daos.PaymentMethodsDAO
+	if len(groupIDs) > 0 {
+		err = db.
+			Joins("Image").
+			Where("payment_methods.group_id IN ?", groupIDs).
+			Order("payment_methods.type, payment_methods.number_of_installments DESC, payment_methods.id").
+			Find(&paymentMethods).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var images daos.ItemImagesDAO
+	err = db.
+		Joins("Image").
+		Where("item_images.item_id IN ?", itemIDs).
+		Order("item_images.id").
+		Find(&images).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews daos.ReviewsDAO
+	err = db.Raw(embeddedReviewsQuery, itemIDs, domain.DefaultPageLimit+1).Scan(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var questions daos.QuestionsDAO
+	err = db.
+		Where("item_id IN ?", itemIDs).
+		Order("created_at DESC, id DESC").
+		Find(&questions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var counts daos.ProductRatingCountsDAO
+	err = db.
+		Model(&daos.ReviewDAO{}).
+		Select("product_id, rating, COUNT(*) AS count").
+		Where("product_id IN ?", productIDs).
+		Group("product_id, rating").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	methodsByGroup := lo.GroupBy(paymentMethods, func(method daos.PaymentMethodDAO) string { return method.GroupID })
+	imagesByItem := lo.GroupBy(images, func(image daos.ItemImageDAO) string { return image.ItemID })
+	reviewsByItem := lo.GroupBy(reviews, func(review daos.ReviewDAO) string { return lo.FromPtr(review.ItemID) })
+	questionsByItem := lo.GroupBy(questions, func(question daos.QuestionDAO) string { return question.ItemID })
+	countsByProduct := counts.ToMaps()
+
+	itemsByID := make(map[string]*domain.Item, len(rows))
+	for _, row := range rows {
+		dao := row.ToItemDAO()
+		if group := dao.UserProduct.Product.PaymentGroup; group != nil {
+			group.PaymentMethods = methodsByGroup[group.ID]
+		}
+		dao.ItemImages = imagesByItem[row.ItemID]
+		dao.Questions = questionsByItem[row.ItemID]
+
+		// only the first page of reviews is embedded, the rest is served by ReviewsRepository.ListByItem
+		page := newReviewPage(reviewsByItem[row.ItemID], domain.ReviewSortNewest, domain.DefaultPageLimit)
+
+		item := dao.ToDomain()
+		item.Reviews = page.Reviews
+		item.ReviewsNextCursor = page.NextCursor
+		item.UserProduct.Product.RatingDistribution = domain.NewRatingDistribution(countsByProduct[row.ProductID])
+		itemsByID[row.ItemID] = item
+	}
+
+	return lo.FilterMap(ids, func(id string, _ int) (*domain.Item, bool) {
+		item, ok := itemsByID[id]
+		return item, ok
+	}), nil
+}
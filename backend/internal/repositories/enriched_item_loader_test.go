@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDbWrapper(t *testing.T) (*DbWrapper, sqlmock.Sqlmock) {
+	t.Helper()
+
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	require.NoError(t, err)
+	return &DbWrapper{DB: db}, mock
+}
+
+var enrichedRowColumns = []string{
+	"item_id", "user_product_id", "title", "product_status",
+	"price_id", "price_value", "currency_id",
+	"product_id", "product_title", "payment_group_id",
+	"seller_id", "seller_name",
+}
+
+func enrichedRow(rows *sqlmock.Rows, itemID, productID string, groupID interface{}) *sqlmock.Rows {
+	return rows.AddRow(itemID, "up-"+itemID, "Title "+itemID, "new",
+		"price-"+itemID, "1500.50", "ARS",
+		productID, "Product "+productID, groupID,
+		"seller-1", "Store")
+}
+
+// expectCollections expects the batched queries that follow the item rows.
+func expectCollections(mock sqlmock.Sqlmock, created time.Time) {
+	mock.ExpectQuery(`FROM "payment_methods" LEFT JOIN "images" "Image"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "type", "Image__id", "Image__url_small_version"}).
+			AddRow("method-1", "group-1", "credit_card", "image-1", "visa.png"))
+	mock.ExpectQuery(`FROM "item_images" LEFT JOIN "images" "Image"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "image_id", "Image__id", "Image__url_small_version"}).
+			AddRow("item-image-1", "item-1", "image-2", "image-2", "front.png").
+			AddRow("item-image-2", "item-2", "image-3", "image-3", "side.png"))
+	mock.ExpectQuery(`ROW_NUMBER\(\) OVER \(PARTITION BY r.item_id`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "seller_id", "item_id", "rating", "content", "created_at"}).
+			AddRow("review-1", "product-1", "seller-1", "item-1", 5, "Great", created))
+	mock.ExpectQuery(`FROM "questions" WHERE item_id IN`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "question", "created_at"}).
+			AddRow("question-1", "item-2", "Is it new?", created))
+	mock.ExpectQuery(`SELECT product_id, rating, COUNT\(\*\) AS count FROM "reviews"`).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "rating", "count"}).
+			AddRow("product-1", 5, 3).
+			AddRow("product-1", 4, 1))
+}
+
+func TestItemsRepository_GetEnriched_AssemblesItem(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`FROM items i JOIN prices p`).
+		WillReturnRows(enrichedRow(sqlmock.NewRows(enrichedRowColumns), "item-1", "product-1", "group-1"))
+	expectCollections(mock, created)
+
+	item, err := New(wrapper).GetEnriched(context.Background(), "item-1")
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, "Title item-1", item.Title)
+	assert.Equal(t, "1500.5", item.Price.Amount.Amount.String())
+	assert.Equal(t, "Store", item.UserProduct.Seller.Name)
+	require.Len(t, item.UserProduct.Product.PaymentGroup.PaymentMethods, 1)
+	assert.Equal(t, "visa.png", item.UserProduct.Product.PaymentGroup.PaymentMethods[0].Image.URLSmallVersion)
+	require.Len(t, item.ItemImages, 1)
+	assert.Equal(t, "front.png", item.ItemImages[0].URLSmallVersion)
+	require.Len(t, item.Reviews, 1)
+	assert.Equal(t, "Great", item.Reviews[0].Content)
+	assert.Empty(t, item.ReviewsNextCursor)
+	assert.Empty(t, item.Questions)
+	assert.Equal(t, 3, item.UserProduct.Product.RatingDistribution[0].Count)
+	assert.Equal(t, 75, item.UserProduct.Product.RatingDistribution[0].Percentage)
+}
+
+func TestItemsRepository_GetEnriched_NotFound(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+	mock.ExpectQuery(`FROM items i JOIN prices p`).
+		WillReturnRows(sqlmock.NewRows(enrichedRowColumns))
+
+	_, err := New(wrapper).GetEnriched(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemsRepository_GetEnrichedBatch_SameQueriesForManyItems(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows(enrichedRowColumns)
+	enrichedRow(rows, "item-1", "product-1", "group-1")
+	enrichedRow(rows, "item-2", "product-2", nil)
+	mock.ExpectQuery(`FROM items i JOIN prices p`).
+		WithArgs("item-2", "missing", "item-1").
+		WillReturnRows(rows)
+	expectCollections(mock, created)
+
+	items, err := New(wrapper).GetEnrichedBatch(context.Background(), []string{"item-2", "missing", "item-1", "item-2"})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, items, 2)
+	assert.Equal(t, "item-2", items[0].ID)
+	assert.Equal(t, "item-1", items[1].ID)
+	assert.Empty(t, items[0].UserProduct.Product.PaymentGroup.PaymentMethods)
+	assert.Equal(t, "side.png", items[0].ItemImages[0].URLSmallVersion)
+	assert.Len(t, items[0].Questions, 1)
+	assert.Empty(t, items[0].Reviews)
+	assert.Len(t, items[1].Reviews, 1)
+	assert.Zero(t, items[0].UserProduct.Product.RatingDistribution[0].Count)
+}
+
+func TestItemsRepository_GetEnrichedBatch_Empty(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+
+	items, err := New(wrapper).GetEnrichedBatch(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, items)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
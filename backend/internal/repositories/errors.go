@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"meli-backend/internal/domain"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// translateError converts GORM and pgx errors into domain errors, so callers
+// never depend on the database driver. resource names what the query was
+// about, e.g. "item", and is used in the client-facing message. Errors with no
+// domain meaning are returned unchanged.
+func translateError(err error, resource string) error {
+	var domainErr *domain.Error
+	if err == nil || errors.As(err, &domainErr) {
+		return err
+	}
+
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return domain.NewNotFound(resource + " not found")
+	case errors.As(err, &pgErr):
+		return translatePgError(pgErr, resource)
+	case isConnectionError(err):
+		return domain.NewUnavailable("database unavailable", err)
+	}
+	return err
+}
+
+// translatePgError maps postgres error codes, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+func translatePgError(pgErr *pgconn.PgError, resource string) error {
+	switch pgErr.Code {
+	case "22P02": // invalid_text_representation, e.g. a malformed UUID
+		return &domain.Error{Kind: domain.ErrInvalidArgument, Message: "malformed identifier", Err: pgErr}
+	case "22001", "23502", "23514": // string_data_right_truncation, not_null_violation, check_violation
+		return &domain.Error{Kind: domain.ErrInvalidArgument, Message: "invalid " + resource, Err: pgErr}
+	case "23503": // foreign_key_violation
+		return &domain.Error{Kind: domain.ErrInvalidArgument, Message: resource + " references a missing resource", Err: pgErr}
+	case "23505": // unique_violation
+		return &domain.Error{Kind: domain.ErrConflict, Message: resource + " already exists", Err: pgErr}
+	case "40001", "40P01": // serialization_failure, deadlock_detected
+		return domain.NewUnavailable("concurrent update, retry the request", pgErr)
+	case "57014", "57P01", "57P02", "57P03": // query_canceled, admin_shutdown, crash_shutdown, cannot_connect_now
+		return domain.NewUnavailable("database unavailable", pgErr)
+	}
+	// connection_exception and insufficient_resources classes
+	if strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "53") {
+		return domain.NewUnavailable("database unavailable", pgErr)
+	}
+	return pgErr
+}
+
+func isConnectionError(err error) bool {
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		kind    error
+		message string
+	}{
+		{"record not found", gorm.ErrRecordNotFound, domain.ErrNotFound, "item not found"},
+		{"wrapped record not found", fmt.Errorf("preload: %w", gorm.ErrRecordNotFound), domain.ErrNotFound, "item not found"},
+		{"invalid uuid", &pgconn.PgError{Code: "22P02"}, domain.ErrInvalidArgument, "malformed identifier"},
+		{"check violation", &pgconn.PgError{Code: "23514"}, domain.ErrInvalidArgument, "invalid item"},
+		{"foreign key violation", &pgconn.PgError{Code: "23503"}, domain.ErrInvalidArgument, "item references a missing resource"},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, domain.ErrConflict, "item already exists"},
+		{"deadlock", &pgconn.PgError{Code: "40P01"}, domain.ErrUnavailable, "concurrent update, retry the request"},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, domain.ErrUnavailable, "database unavailable"},
+		{"too many connections", &pgconn.PgError{Code: "53300"}, domain.ErrUnavailable, "database unavailable"},
+		{"bad connection", driver.ErrBadConn, domain.ErrUnavailable, "database unavailable"},
+		{"timeout", context.DeadlineExceeded, domain.ErrUnavailable, "database unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := translateError(tt.err, "item")
+
+			assert.ErrorIs(t, err, tt.kind)
+			assert.Equal(t, tt.message, domain.ErrorMessage(err))
+		})
+	}
+}
+
+func TestTranslateError_KeepsCause(t *testing.T) {
+	pgErr := &pgconn.PgError{Code: "22P02", Message: `invalid input syntax for type uuid: "abc"`}
+
+	err := translateError(pgErr, "item")
+
+	var cause *pgconn.PgError
+	assert.True(t, errors.As(err, &cause))
+	assert.Same(t, pgErr, cause)
+}
+
+func TestTranslateError_Unchanged(t *testing.T) {
+	assert.NoError(t, translateError(nil, "item"))
+
+	domainErr := domain.NewConflict("question is already answered")
+	assert.Same(t, domainErr, translateError(domainErr, "question"))
+
+	other := errors.New("boom")
+	assert.Same(t, other, translateError(other, "item"))
+
+	syntaxErr := &pgconn.PgError{Code: "42601"}
+	assert.Same(t, syntaxErr, translateError(syntaxErr, "item"))
+}
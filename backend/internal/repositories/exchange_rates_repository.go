@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+)
+
+type ExchangeRatesRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewExchangeRatesRepository(dbWrapper *DbWrapper) *ExchangeRatesRepository {
+	return &ExchangeRatesRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// Get returns the stored rate from base to quote. Inverse and cross rates are
+// derived by the caller, so only the exact pair is looked up.
+func (r *ExchangeRatesRepository) Get(ctx context.Context, base, quote string) (*domain.ExchangeRate, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "exchange_rates", "Get")
+	defer end()
+
+	var rate daos.ExchangeRateDAO
+	err := r.dbWrapper.DB.WithContext(ctx).
+		Where("base_currency = ? AND quote_currency = ?", base, quote).
+		First(&rate).Error
+	if err != nil {
+		return nil, translateError(err, "exchange rate")
+	}
+	return rate.ToDomain(), nil
+}
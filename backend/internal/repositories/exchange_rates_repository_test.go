@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExchangeRatesRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewExchangeRatesRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestExchangeRatesRepository_Get_WithNilDB(t *testing.T) {
+	repo := NewExchangeRatesRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.Get(context.Background(), "USD", "COP")
+	})
+}
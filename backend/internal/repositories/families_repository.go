@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+)
+
+type FamiliesRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewFamiliesRepository(dbWrapper *DbWrapper) *FamiliesRepository {
+	return &FamiliesRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// GetItems returns the family and every item sold for any product of it,
+// along with the main spec of each item's product.
+func (r *FamiliesRepository) GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "families", "GetItems")
+	defer end()
+
+	var family daos.FamilyDAO
+	err := r.dbWrapper.DB.WithContext(ctx).Where("family_id = ?", familyID).First(&family).Error
+	if err != nil {
+		return nil, translateError(err, "family")
+	}
+
+	var rows daos.FamilyVariantsDAO
+	err = joinItemSummary(
+		r.dbWrapper.DB.WithContext(ctx).
+			Table("items i").
+			Select(itemSummaryColumns+", pr.id AS product_id, pr.main_spec"),
+	).
+		Where("pr.family_id = ?", familyID).
+		Order("pr.title, p.value, i.item_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "family")
+	}
+
+	return &domain.FamilyItems{
+		Family:   *family.ToDomain(),
+		Variants: rows.ToDomain(),
+	}, nil
+}
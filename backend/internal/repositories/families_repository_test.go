@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFamiliesRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewFamiliesRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestFamiliesRepository_GetItems_WithNilDB(t *testing.T) {
+	repo := NewFamiliesRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.GetItems(context.Background(), "test-family-id")
+	})
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"meli-backend/internal/domain"
+)
+
+type HealthRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewHealthRepository(dbWrapper *DbWrapper) *HealthRepository {
+	return &HealthRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+func (r *HealthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.dbWrapper.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (r *HealthRepository) PoolStats() (domain.PoolStats, error) {
+	sqlDB, err := r.dbWrapper.DB.DB()
+	if err != nil {
+		return domain.PoolStats{}, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	stats := sqlDB.Stats()
+	return domain.PoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDuration:       stats.WaitDuration,
+	}, nil
+}
+
+// MigrationVersion returns the newest version recorded in schema_migrations,
+// by `meli-backend migrate` or dbmate.
+func (r *HealthRepository) MigrationVersion(ctx context.Context) (string, error) {
+	var versions []string
+	err := r.dbWrapper.DB.WithContext(ctx).
+		Table("schema_migrations").
+		Order("version DESC").
+		Limit(1).
+		Pluck("version", &versions).Error
+	if err != nil {
+		return "", translateError(err, "schema migration")
+	}
+	if len(versions) == 0 {
+		return "", domain.NewNotFound("no migration has been applied")
+	}
+	return versions[0], nil
+}
@@ -1,9 +1,16 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
 	"meli-backend/internal/domain"
+	"meli-backend/internal/logging"
 	daos "meli-backend/internal/repositories/daos"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 )
 
 type ItemsRepository struct {
@@ -16,43 +23,218 @@ func New(dbWrapper *DbWrapper) *ItemsRepository {
 	}
 }
 
-func (r *ItemsRepository) GetEnriched(itemID string) (*domain.Item, error) {
-	enrichedDAO, err := r.getEnrichedDAO(itemID)
+func (r *ItemsRepository) GetEnriched(ctx context.Context, itemID string) (*domain.Item, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "items", "GetEnriched")
+	defer end()
+
+	items, err := loadEnrichedItems(r.dbWrapper.DB.WithContext(ctx), []string{itemID})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, "item")
+	}
+	if len(items) == 0 {
+		return nil, domain.NewNotFound("item not found")
 	}
 
-	return enrichedDAO.ToDomain(), nil
+	item := items[0]
+	logging.FromContext(ctx).Debug("enriched item loaded",
+		"item_id", itemID,
+		"reviews", len(item.Reviews),
+		"questions", len(item.Questions),
+	)
+	return item, nil
 }
 
-func (r *ItemsRepository) getEnrichedDAO(itemID string) (*daos.ItemDAO, error) {
-	var item daos.ItemDAO
+// GetEnrichedBatch loads the enriched items with the given IDs, with as many
+// queries as GetEnriched takes for one. Items come back in the order of ids,
+// duplicates once; IDs matching no item are left out.
+func (r *ItemsRepository) GetEnrichedBatch(ctx context.Context, ids []string) ([]*domain.Item, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "items", "GetEnrichedBatch")
+	defer end()
 
-	fmt.Printf("Attempting to get item with ID: %s\n", itemID)
+	if len(ids) == 0 {
+		return nil, nil
+	}
 
-	err := r.dbWrapper.DB.
-		Preload("Price").
-		Preload("UserProduct").
-		Preload("UserProduct.Product").
-		Preload("UserProduct.Product.Family").
-		Preload("UserProduct.Product.AggregatedReview").
-		Preload("UserProduct.Seller").
-		Preload("UserProduct.Seller.Image").
-		Preload("UserProduct.Product.PaymentGroup").
-		Preload("UserProduct.Product.PaymentGroup.PaymentMethods").
-		Preload("UserProduct.Product.PaymentGroup.PaymentMethods.Image").
-		Preload("ItemImages").
-		Preload("ItemImages.Image").
-		Preload("Reviews").
-		Preload("Questions").
-		Where("item_id = ?", itemID).
-		First(&item).Error
+	items, err := loadEnrichedItems(r.dbWrapper.DB.WithContext(ctx), ids)
+	if err != nil {
+		return nil, translateError(err, "item")
+	}
+
+	logging.FromContext(ctx).Debug("enriched items loaded",
+		"requested", len(ids),
+		"found", len(items),
+	)
+	return items, nil
+}
+
+// itemSortSpec describes how a listing sort is expressed in SQL and how the
+// sort key of a row is carried inside the page cursor. value returns the sort
+// key of a row as parseKey does for a cursor, so the in-memory repository can
+// order rows the same way.
+type itemSortSpec struct {
+	column     string
+	descending bool
+	key        func(row daos.ItemSummaryDAO) string
+	parseKey   func(key string) (interface{}, error)
+	value      func(row daos.ItemSummaryDAO) interface{}
+}
+
+var itemSortSpecs = map[domain.ItemSort]itemSortSpec{
+	domain.ItemSortNewest: {
+		column:     "i.created_at",
+		descending: true,
+		key:        func(row daos.ItemSummaryDAO) string { return row.CreatedAt.Format(time.RFC3339Nano) },
+		parseKey:   func(key string) (interface{}, error) { return time.Parse(time.RFC3339Nano, key) },
+		value:      func(row daos.ItemSummaryDAO) interface{} { return row.CreatedAt },
+	},
+	domain.ItemSortPriceAsc: {
+		column:   "p.value",
+		key:      func(row daos.ItemSummaryDAO) string { return row.PriceValue.String() },
+		parseKey: parseDecimalKey,
+		value:    func(row daos.ItemSummaryDAO) interface{} { return row.PriceValue },
+	},
+	domain.ItemSortPriceDesc: {
+		column:     "p.value",
+		descending: true,
+		key:        func(row daos.ItemSummaryDAO) string { return row.PriceValue.String() },
+		parseKey:   parseDecimalKey,
+		value:      func(row daos.ItemSummaryDAO) interface{} { return row.PriceValue },
+	},
+	domain.ItemSortRating: {
+		column:     "COALESCE(ar.rating_value, 0)",
+		descending: true,
+		key:        func(row daos.ItemSummaryDAO) string { return formatFloatKey(row.RatingValue) },
+		parseKey:   parseFloatKey,
+		value:      func(row daos.ItemSummaryDAO) interface{} { return row.RatingValue },
+	},
+}
 
+// List returns a page of item summaries. It runs a single query joining only
+// the tables needed to render a listing card, instead of loading the whole
+// enriched item graph.
+func (r *ItemsRepository) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "items", "List")
+	defer end()
+
+	if query.Sort == "" {
+		query.Sort = domain.ItemSortNewest
+	}
+	spec, ok := itemSortSpecs[query.Sort]
+	if !ok {
+		return nil, domain.NewInvalidArgument(fmt.Sprintf("unsupported sort %q", query.Sort))
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, string(query.Sort))
 	if err != nil {
-		fmt.Printf("Error getting item: %v\n", err)
 		return nil, err
 	}
 
-	fmt.Printf("Successfully retrieved item: %+v\n", item)
-	return &item, nil
+	db := applyItemFilter(r.itemSummaryQuery(ctx), query.Filter)
+
+	direction, comparison := "ASC", ">"
+	if spec.descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if cursor != nil {
+		key, err := spec.parseKey(cursor.Key)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		db = db.Where(fmt.Sprintf("(%s, i.item_id) %s (?, ?)", spec.column, comparison), key, cursor.ID)
+	}
+
+	var rows daos.ItemSummariesDAO
+	err = db.
+		Order(fmt.Sprintf("%s %s, i.item_id %s", spec.column, direction, direction)).
+		Limit(limit + 1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "item")
+	}
+
+	return newItemSummaryPage(rows, query.Sort, spec, limit), nil
+}
+
+// newItemSummaryPage trims the extra row fetched to detect a next page and builds its cursor.
+func newItemSummaryPage(rows daos.ItemSummariesDAO, sort domain.ItemSort, spec itemSortSpec, limit int) *domain.ItemSummaryPage {
+	page := &domain.ItemSummaryPage{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort: string(sort),
+			Key:  spec.key(last),
+			ID:   last.ItemID,
+		})
+	}
+	page.Items = rows.ToDomain()
+	return page
+}
+
+// itemSummaryColumns are the columns scanned into daos.ItemSummaryDAO.
+const itemSummaryColumns = `i.item_id, i.title, i.product_status, i.created_at,
+	p.id AS price_id, p.value AS price_value, p.currency_symbol, p.currency_id,
+	img.id AS image_id, img.url_small_version AS image_url_small_version,
+	img.url_medium_version AS image_url_medium_version, img.alt AS image_alt,
+	COALESCE(ar.rating_value, 0) AS rating_value, COALESCE(ar.rating_count, 0) AS rating_count`
+
+func (r *ItemsRepository) itemSummaryQuery(ctx context.Context) *gorm.DB {
+	return joinItemSummary(r.dbWrapper.DB.WithContext(ctx).Table("items i").Select(itemSummaryColumns))
+}
+
+// joinItemSummary adds the joins needed by itemSummaryColumns to a query over "items i".
+func joinItemSummary(db *gorm.DB) *gorm.DB {
+	return db.
+		Joins("JOIN prices p ON p.id = i.price_id_fk").
+		Joins("JOIN user_products up ON up.id = i.user_product_id").
+		Joins("JOIN products pr ON pr.id = up.product_id").
+		Joins("LEFT JOIN aggregated_reviews ar ON ar.product_id = pr.id").
+		Joins(`LEFT JOIN LATERAL (
+			SELECT im.id, im.url_small_version, im.url_medium_version, im.alt
+			FROM item_images ii
+			JOIN images im ON im.id = ii.image_id
+			WHERE ii.item_id = i.item_id
+			ORDER BY ii.id
+			LIMIT 1
+		) img ON true`)
+}
+
+func applyItemFilter(db *gorm.DB, filter domain.ItemFilter) *gorm.DB {
+	if filter.FamilyID != "" {
+		db = db.Where("pr.family_id = ?", filter.FamilyID)
+	}
+	if filter.Status != "" {
+		db = db.Where("i.product_status = ?", filter.Status)
+	}
+	if filter.SellerID != "" {
+		db = db.Where("up.seller_id = ?", filter.SellerID)
+	}
+	if filter.MinPrice != nil {
+		db = db.Where("p.value >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		db = db.Where("p.value <= ?", *filter.MaxPrice)
+	}
+	if filter.InStock {
+		db = db.Where("i.available_quantity > 0")
+	}
+	return db
+}
+
+func formatFloatKey(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func parseFloatKey(key string) (interface{}, error) {
+	return strconv.ParseFloat(key, 64)
+}
+
+func parseDecimalKey(key string) (interface{}, error) {
+	return decimal.NewFromString(key)
 }
@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The benchmarks compare the enriched item loader with the preload chain it
+// replaced. They need a migrated and seeded database:
+//
+//	TEST_DATABASE_URL=postgres://... go test -run '^$' -bench Enriched ./internal/repositories/
+//
+// and report the number of SQL round trips per operation next to the timings.
+
+// loaderQueries is the number of statements the enriched item loader runs,
+// whatever the number of items: the item rows, payment methods (skipped
+// when no item has a payment group), images, reviews, questions and rating
+// counts.
+const loaderQueries = 6
+
+// queryCounter counts the statements run through a gorm.DB.
+type queryCounter struct {
+	queries atomic.Int64
+}
+
+// register counts the statements of Find and First (query), of Scan, Row
+// and Rows (row) and of Exec (raw). Preloads run through the query
+// callbacks again, once per preloaded relation.
+func (c *queryCounter) register(db *gorm.DB) error {
+	count := func(*gorm.DB) { c.queries.Add(1) }
+	if err := db.Callback().Query().After("gorm:query").Register("bench:count_query", count); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("bench:count_row", count); err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("gorm:raw").Register("bench:count_raw", count)
+}
+
+func TestQueryCounter_CountsLoaderStatements(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+	counter := &queryCounter{}
+	require.NoError(t, counter.register(wrapper.DB))
+
+	rows := sqlmock.NewRows(enrichedRowColumns)
+	enrichedRow(rows, "item-1", "product-1", "group-1")
+	enrichedRow(rows, "item-2", "product-2", nil)
+	mock.ExpectQuery(`FROM items i JOIN prices p`).WillReturnRows(rows)
+	expectCollections(mock, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	_, err := New(wrapper).GetEnrichedBatch(context.Background(), []string{"item-1", "item-2"})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, int64(loaderQueries), counter.queries.Load())
+}
+
+func TestQueryCounter_CountsScan(t *testing.T) {
+	wrapper, mock := newMockDbWrapper(t)
+	counter := &queryCounter{}
+	require.NoError(t, counter.register(wrapper.DB))
+
+	mock.ExpectQuery(`SELECT rating, COUNT\(\*\) AS count FROM "reviews"`).
+		WillReturnRows(sqlmock.NewRows([]string{"rating", "count"}).AddRow(5, 2))
+
+	_, err := ratingDistribution(wrapper.DB, "product_id", "product-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), counter.queries.Load())
+}
+
+func openBenchmarkDB(b *testing.B) (*DbWrapper, *queryCounter, []string) {
+	b.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		b.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	var ids []string
+	require.NoError(b, db.Table("items").Order("item_id").Limit(domain.DefaultPageLimit).Pluck("item_id", &ids).Error)
+	if len(ids) == 0 {
+		b.Skip("the database has no items, load the seeds first")
+	}
+
+	counter := &queryCounter{}
+	require.NoError(b, counter.register(db))
+	return &DbWrapper{DB: db}, counter, ids
+}
+
+// legacyGetEnrichedDAO is the preload chain GetEnriched ran before the
+// batched loader, kept as the baseline of the benchmarks.
+func legacyGetEnrichedDAO(db *gorm.DB, itemID string) (*daos.ItemDAO, error) {
+	var item daos.ItemDAO
+	err := db.
+		Preload("Price").
+		Preload("UserProduct").
+		Preload("UserProduct.Product").
+		Preload("UserProduct.Product.Family").
+		Preload("UserProduct.Product.AggregatedReview").
+		Preload("UserProduct.Seller").
+		Preload("UserProduct.Seller.Image").
+		Preload("UserProduct.Product.PaymentGroup").
+		Preload("UserProduct.Product.PaymentGroup.PaymentMethods").
+		Preload("UserProduct.Product.PaymentGroup.PaymentMethods.Image").
+		Preload("ItemImages").
+		Preload("ItemImages.Image").
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at DESC, id DESC").Limit(domain.DefaultPageLimit + 1)
+		}).
+		Preload("Questions").
+		Where("item_id = ?", itemID).
+		First(&item).Error
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ratingDistribution(db, "product_id", item.UserProduct.ProductID)
+	return &item, err
+}
+
+// reportQueries reports the statements per operation and returns them.
+func reportQueries(b *testing.B, counter *queryCounter) float64 {
+	perOp := float64(counter.queries.Load()) / float64(b.N)
+	b.ReportMetric(perOp, "queries/op")
+	return perOp
+}
+
+func BenchmarkGetEnriched_Preload(b *testing.B) {
+	wrapper, counter, ids := openBenchmarkDB(b)
+	counter.queries.Store(0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := legacyGetEnrichedDAO(wrapper.DB, ids[i%len(ids)])
+		require.NoError(b, err)
+	}
+	reportQueries(b, counter)
+}
+
+func BenchmarkGetEnriched_Loader(b *testing.B) {
+	wrapper, counter, ids := openBenchmarkDB(b)
+	repo := New(wrapper)
+	counter.queries.Store(0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := repo.GetEnriched(context.Background(), ids[i%len(ids)])
+		require.NoError(b, err)
+	}
+	require.LessOrEqual(b, reportQueries(b, counter), float64(loaderQueries))
+}
+
+// The page benchmarks load a listing page worth of items, as a caller of
+// GetEnrichedBatch would.
+func BenchmarkGetEnrichedPage_Preload(b *testing.B) {
+	wrapper, counter, ids := openBenchmarkDB(b)
+	counter.queries.Store(0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, id := range ids {
+			_, err := legacyGetEnrichedDAO(wrapper.DB, id)
+			require.NoError(b, err)
+		}
+	}
+	reportQueries(b, counter)
+}
+
+func BenchmarkGetEnrichedPage_Batch(b *testing.B) {
+	wrapper, counter, ids := openBenchmarkDB(b)
+	repo := New(wrapper)
+	counter.queries.Store(0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		items, err := repo.GetEnrichedBatch(context.Background(), ids)
+		require.NoError(b, err)
+		require.Len(b, items, len(ids))
+	}
+	require.LessOrEqual(b, reportQueries(b, counter), float64(loaderQueries))
+}
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"context"
+	"meli-backend/internal/domain"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,7 +20,7 @@ func TestItemsRepository_GetEnriched_WithNilDbWrapper(t *testing.T) {
 	repo := New(nil)
 
 	assert.Panics(t, func() {
-		repo.GetEnriched("test-id")
+		repo.GetEnriched(context.Background(), "test-id")
 	})
 }
 
@@ -27,7 +29,7 @@ func TestItemsRepository_GetEnriched_WithEmptyDbWrapper(t *testing.T) {
 	repo := New(emptyDbWrapper)
 
 	assert.Panics(t, func() {
-		repo.GetEnriched("test-id")
+		repo.GetEnriched(context.Background(), "test-id")
 	})
 }
 
@@ -36,7 +38,7 @@ func TestItemsRepository_GetEnriched_WithValidDbWrapper(t *testing.T) {
 	repo := New(validDbWrapper)
 
 	assert.Panics(t, func() {
-		repo.GetEnriched("test-id")
+		repo.GetEnriched(context.Background(), "test-id")
 	})
 }
 
@@ -47,6 +49,22 @@ func TestItemsRepository_GetEnriched_WithEmptyID(t *testing.T) {
 
 	// This should panic when trying to access the DB
 	assert.Panics(t, func() {
-		repo.GetEnriched("")
+		repo.GetEnriched(context.Background(), "")
 	})
 }
+
+func TestItemsRepository_List_WithUnsupportedSort(t *testing.T) {
+	repo := New(&DbWrapper{})
+
+	_, err := repo.List(context.Background(), domain.ItemListQuery{Sort: "cheapest"})
+
+	assert.Error(t, err)
+}
+
+func TestItemsRepository_List_WithInvalidCursor(t *testing.T) {
+	repo := New(&DbWrapper{})
+
+	_, err := repo.List(context.Background(), domain.ItemListQuery{Sort: domain.ItemSortNewest, Cursor: "not a cursor!"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// MemoryItemsRepository is the in-memory counterpart of ItemsRepository.
+type MemoryItemsRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryItemsRepository(store *MemoryStore) *MemoryItemsRepository {
+	return &MemoryItemsRepository{store: store}
+}
+
+func (r *MemoryItemsRepository) GetEnriched(ctx context.Context, itemID string) (*domain.Item, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row, ok := r.store.items[itemID]
+	if !ok {
+		return nil, domain.NewNotFound("item not found")
+	}
+
+	dao := row.ItemDAO
+	if price, ok := r.store.prices[dao.PriceIDFK]; ok {
+		dao.Price = &price
+	}
+	dao.UserProduct = r.store.userProduct(dao.UserProductID)
+	dao.ItemImages = r.store.imagesOf(itemID)
+	dao.Questions = r.store.questionsOf(itemID)
+
+	page := newReviewPage(r.store.filterReviews(func(review daos.ReviewDAO) bool {
+		return lo.FromPtr(review.ItemID) == itemID
+	}), domain.ReviewSortNewest, domain.DefaultPageLimit)
+
+	item := dao.ToDomain()
+	item.Reviews = page.Reviews
+	item.ReviewsNextCursor = page.NextCursor
+	if dao.UserProduct != nil {
+		productID := dao.UserProduct.ProductID
+		item.UserProduct.Product.RatingDistribution = domain.NewRatingDistribution(ratingCounts(r.store.filterReviews(
+			func(review daos.ReviewDAO) bool { return review.ProductID == productID },
+		)))
+	}
+	return item, nil
+}
+
+func (r *MemoryItemsRepository) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	if query.Sort == "" {
+		query.Sort = domain.ItemSortNewest
+	}
+	spec, ok := itemSortSpecs[query.Sort]
+	if !ok {
+		return nil, domain.NewInvalidArgument(fmt.Sprintf("unsupported sort %q", query.Sort))
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, string(query.Sort))
+	if err != nil {
+		return nil, err
+	}
+	var after interface{}
+	if cursor != nil {
+		if after, err = spec.parseKey(cursor.Key); err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+	}
+
+	// compare orders rows as the listing does, on the sort column then item ID
+	compare := func(value interface{}, id string, otherValue interface{}, otherID string) int {
+		order := cmp.Or(compareSortValues(value, otherValue), strings.Compare(id, otherID))
+		if spec.descending {
+			return -order
+		}
+		return order
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var rows daos.ItemSummariesDAO
+	for _, item := range r.store.items {
+		row, ok := r.store.itemSummary(item, query.Filter)
+		if !ok {
+			continue
+		}
+		if cursor != nil && compare(spec.value(row), row.ItemID, after, cursor.ID) <= 0 {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	slices.SortFunc(rows, func(a, b daos.ItemSummaryDAO) int {
+		return compare(spec.value(a), a.ItemID, spec.value(b), b.ItemID)
+	})
+	if len(rows) > limit+1 {
+		rows = rows[:limit+1]
+	}
+
+	return newItemSummaryPage(rows, query.Sort, spec, limit), nil
+}
+
+// itemSummary returns the listing row of the item, unless it is filtered out.
+func (s *MemoryStore) itemSummary(item memoryItem, filter domain.ItemFilter) (daos.ItemSummaryDAO, bool) {
+	price := s.prices[item.PriceIDFK]
+	userProduct := s.userProducts[item.UserProductID]
+	product := s.products[userProduct.ProductID]
+
+	switch {
+	case filter.FamilyID != "" && lo.FromPtr(product.FamilyID) != filter.FamilyID,
+		filter.Status != "" && item.ProductStatus != filter.Status,
+		filter.SellerID != "" && userProduct.SellerID != filter.SellerID,
+		filter.MinPrice != nil && price.Value.LessThan(decimal.NewFromFloat(*filter.MinPrice)),
+		filter.MaxPrice != nil && price.Value.GreaterThan(decimal.NewFromFloat(*filter.MaxPrice)),
+		filter.InStock && item.AvailableQuantity <= 0:
+		return daos.ItemSummaryDAO{}, false
+	}
+
+	row := daos.ItemSummaryDAO{
+		ItemID:         item.ItemID,
+		Title:          item.Title,
+		ProductStatus:  item.ProductStatus,
+		CreatedAt:      item.CreatedAt,
+		PriceID:        price.ID,
+		PriceValue:     price.Value,
+		CurrencySymbol: price.CurrencySymbol,
+		CurrencyID:     price.CurrencyID,
+	}
+	if images := s.imagesOf(item.ItemID); len(images) > 0 {
+		image := images[0].Image
+		row.ImageID = &image.ID
+		row.ImageURLSmallVersion = &image.URLSmallVersion
+		row.ImageURLMediumVersion = &image.URLMediumVersion
+		row.ImageAlt = &image.Alt
+	}
+	if aggregatedReview, ok := s.aggregatedReviews[product.ID]; ok {
+		row.RatingValue = aggregatedReview.RatingValue
+		row.RatingCount = aggregatedReview.RatingCount
+	}
+	return row, true
+}
+
+// compareSortValues compares two values returned by itemSortSpec.value or
+// itemSortSpec.parseKey.
+func compareSortValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case decimal.Decimal:
+		return a.Cmp(b.(decimal.Decimal))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	}
+	panic(fmt.Sprintf("unsupported sort value %T", a))
+}
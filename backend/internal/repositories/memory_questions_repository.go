@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MemoryQuestionsRepository is the in-memory counterpart of QuestionsRepository.
+type MemoryQuestionsRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryQuestionsRepository(store *MemoryStore) *MemoryQuestionsRepository {
+	return &MemoryQuestionsRepository{store: store}
+}
+
+func (r *MemoryQuestionsRepository) Create(ctx context.Context, itemID, text string) (*domain.Question, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if err := r.store.itemExists(itemID); err != nil {
+		return nil, err
+	}
+
+	question := daos.QuestionDAO{
+		ID:        uuid.NewString(),
+		ItemID:    itemID,
+		Question:  text,
+		CreatedAt: r.store.timestamp(),
+	}
+	r.store.questions[question.ID] = question
+
+	return question.ToDomain(), nil
+}
+
+func (r *MemoryQuestionsRepository) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	question, ok := r.store.questions[questionID]
+	if !ok {
+		return nil, domain.NewNotFound("question not found")
+	}
+	if question.Answer != "" {
+		return nil, domain.NewConflict("question is already answered")
+	}
+
+	question.Answer = answer
+	question.AnsweredAt = lo.ToPtr(r.store.timestamp())
+	r.store.questions[questionID] = question
+
+	return question.ToDomain(), nil
+}
+
+func (r *MemoryQuestionsRepository) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, questionSort)
+	if err != nil {
+		return nil, err
+	}
+	var after daos.QuestionDAO
+	if cursor != nil {
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Key)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		after = daos.QuestionDAO{ID: cursor.ID, CreatedAt: createdAt}
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if err := r.store.itemExists(query.ItemID); err != nil {
+		return nil, err
+	}
+
+	var questions daos.QuestionsDAO
+	for _, question := range r.store.questions {
+		switch {
+		case question.ItemID != query.ItemID,
+			query.Status == domain.QuestionStatusAnswered && question.Answer == "",
+			query.Status == domain.QuestionStatusUnanswered && question.Answer != "",
+			cursor != nil && compareQuestions(question, after) <= 0:
+			continue
+		}
+		questions = append(questions, question)
+	}
+	slices.SortFunc(questions, compareQuestions)
+	if len(questions) > limit+1 {
+		questions = questions[:limit+1]
+	}
+
+	return newQuestionPage(questions, limit), nil
+}
+
+// questionsOf returns the questions of the item, newest first.
+func (s *MemoryStore) questionsOf(itemID string) daos.QuestionsDAO {
+	var questions daos.QuestionsDAO
+	for _, question := range s.questions {
+		if question.ItemID == itemID {
+			questions = append(questions, question)
+		}
+	}
+	slices.SortFunc(questions, compareQuestions)
+	return questions
+}
+
+// compareQuestions orders questions newest first, as ListByItem does.
+func compareQuestions(a, b daos.QuestionDAO) int {
+	return -cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MemoryReviewsRepository is the in-memory counterpart of ReviewsRepository.
+type MemoryReviewsRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryReviewsRepository(store *MemoryStore) *MemoryReviewsRepository {
+	return &MemoryReviewsRepository{store: store}
+}
+
+func (r *MemoryReviewsRepository) Create(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	item, ok := r.store.items[review.ItemID]
+	if !ok {
+		return nil, domain.NewNotFound("item not found")
+	}
+	// the rating check constraint of the reviews table
+	if review.Rating < domain.MinReviewRating || review.Rating > domain.MaxReviewRating {
+		return nil, domain.NewInvalidArgument("invalid review")
+	}
+	userProduct := r.store.userProducts[item.UserProductID]
+
+	itemID := review.ItemID
+	reviewDAO := daos.ReviewDAO{
+		ID:        uuid.NewString(),
+		ProductID: userProduct.ProductID,
+		SellerID:  userProduct.SellerID,
+		ItemID:    &itemID,
+		Rating:    review.Rating,
+		Content:   review.Content,
+		CreatedAt: r.store.timestamp(),
+	}
+	r.store.reviews[reviewDAO.ID] = reviewDAO
+
+	aggregatedReview, ok := r.store.aggregatedReviews[userProduct.ProductID]
+	if !ok {
+		aggregatedReview = daos.AggregatedReviewDAO{ID: uuid.NewString(), ProductID: userProduct.ProductID}
+	}
+	productReviews := r.store.filterReviews(func(other daos.ReviewDAO) bool {
+		return other.ProductID == userProduct.ProductID
+	})
+	aggregatedReview.RatingCount = len(productReviews)
+	aggregatedReview.RatingValue = averageRating(productReviews)
+	r.store.aggregatedReviews[userProduct.ProductID] = aggregatedReview
+
+	var productItemIDs []string
+	for id, other := range r.store.items {
+		if r.store.userProducts[other.UserProductID].ProductID == userProduct.ProductID {
+			productItemIDs = append(productItemIDs, id)
+		}
+	}
+	slices.Sort(productItemIDs)
+
+	return &domain.SubmittedReview{
+		Review:           *reviewDAO.ToDomain(),
+		AggregatedReview: *aggregatedReview.ToDomain(),
+		ProductItemIDs:   productItemIDs,
+	}, nil
+}
+
+func (r *MemoryReviewsRepository) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	if query.Sort == "" {
+		query.Sort = domain.ReviewSortNewest
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, string(query.Sort))
+	if err != nil {
+		return nil, err
+	}
+	var after daos.ReviewDAO
+	if cursor != nil {
+		rating, createdAt, err := parseReviewCursorKey(cursor.Key)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		after = daos.ReviewDAO{ID: cursor.ID, Rating: rating, CreatedAt: createdAt}
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	item, ok := r.store.items[query.ItemID]
+	if !ok {
+		return nil, domain.NewNotFound("item not found")
+	}
+	productID := r.store.userProducts[item.UserProductID].ProductID
+
+	compare := compareReviews(query.Sort)
+	reviews := r.store.filterReviews(func(review daos.ReviewDAO) bool {
+		switch {
+		case query.Scope == domain.ReviewScopeProduct && review.ProductID != productID,
+			query.Scope != domain.ReviewScopeProduct && lo.FromPtr(review.ItemID) != query.ItemID,
+			query.Rating != 0 && review.Rating != query.Rating,
+			cursor != nil && compare(review, after) <= 0:
+			return false
+		}
+		return true
+	})
+	slices.SortFunc(reviews, compare)
+	if len(reviews) > limit+1 {
+		reviews = reviews[:limit+1]
+	}
+
+	return newReviewPage(reviews, query.Sort, limit), nil
+}
+
+// compareReviews orders reviews as ListByItem does for sort: by rating for
+// the rating sorts, then newest first.
+func compareReviews(sort domain.ReviewSort) func(a, b daos.ReviewDAO) int {
+	return func(a, b daos.ReviewDAO) int {
+		newest := -cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+		switch sort {
+		case domain.ReviewSortHighest:
+			return cmp.Or(-cmp.Compare(a.Rating, b.Rating), newest)
+		case domain.ReviewSortLowest:
+			return cmp.Or(cmp.Compare(a.Rating, b.Rating), newest)
+		}
+		return newest
+	}
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+)
+
+// MemorySellersRepository is the in-memory counterpart of SellersRepository.
+type MemorySellersRepository struct {
+	store *MemoryStore
+}
+
+func NewMemorySellersRepository(store *MemoryStore) *MemorySellersRepository {
+	return &MemorySellersRepository{store: store}
+}
+
+func (r *MemorySellersRepository) GetProfile(ctx context.Context, sellerID string) (*domain.SellerProfile, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	seller, ok := r.store.sellers[sellerID]
+	if !ok {
+		return nil, domain.NewNotFound("seller not found")
+	}
+	seller.Image = r.store.image(seller.ImageID)
+
+	var stats daos.SellerStatsDAO
+	products := map[string]bool{}
+	for _, userProduct := range r.store.userProducts {
+		if userProduct.SellerID == sellerID {
+			products[userProduct.ProductID] = true
+		}
+	}
+	stats.ProductsCount = len(products)
+	for _, item := range r.store.items {
+		if r.store.userProducts[item.UserProductID].SellerID != sellerID {
+			continue
+		}
+		stats.ItemsCount++
+		if item.AvailableQuantity > 0 {
+			stats.ActiveItemsCount++
+		}
+	}
+
+	reviews := r.store.filterReviews(func(review daos.ReviewDAO) bool { return review.SellerID == sellerID })
+	stats.ReviewsCount = len(reviews)
+	stats.RatingValue = averageRating(reviews)
+
+	return &domain.SellerProfile{
+		Seller:             *seller.ToDomain(),
+		Stats:              *stats.ToDomain(),
+		RatingDistribution: domain.NewRatingDistribution(ratingCounts(reviews)),
+	}, nil
+}
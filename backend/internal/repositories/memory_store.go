@@ -0,0 +1,392 @@
+package repositories
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"math"
+	"meli-backend/internal/db/seeds"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// MemoryStore keeps the catalog in memory for the Memory* repositories, so
+// services and handlers can be exercised without Postgres. It is safe for
+// concurrent use. The repositories are held to the same behavior as the GORM
+// ones by the conformance suite of this package.
+type MemoryStore struct {
+	mu  sync.RWMutex
+	now func() time.Time
+
+	images            map[string]daos.ImageDAO
+	sellers           map[string]daos.SellerDAO
+	families          map[string]daos.FamilyDAO
+	prices            map[string]daos.PriceDAO
+	paymentGroups     map[string]bool
+	paymentMethods    map[string]daos.PaymentMethodDAO
+	products          map[string]daos.ProductDAO
+	userProducts      map[string]daos.UserProductDAO
+	items             map[string]memoryItem
+	itemImages        map[string]daos.ItemImageDAO
+	aggregatedReviews map[string]daos.AggregatedReviewDAO // by product ID
+	questions         map[string]daos.QuestionDAO
+	reviews           map[string]daos.ReviewDAO
+}
+
+// memoryItem is an items row; ItemDAO does not map created_at.
+type memoryItem struct {
+	daos.ItemDAO
+	CreatedAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return newMemoryStore(time.Now)
+}
+
+func newMemoryStore(now func() time.Time) *MemoryStore {
+	return &MemoryStore{
+		now:               now,
+		images:            map[string]daos.ImageDAO{},
+		sellers:           map[string]daos.SellerDAO{},
+		families:          map[string]daos.FamilyDAO{},
+		prices:            map[string]daos.PriceDAO{},
+		paymentGroups:     map[string]bool{},
+		paymentMethods:    map[string]daos.PaymentMethodDAO{},
+		products:          map[string]daos.ProductDAO{},
+		userProducts:      map[string]daos.UserProductDAO{},
+		items:             map[string]memoryItem{},
+		itemImages:        map[string]daos.ItemImageDAO{},
+		aggregatedReviews: map[string]daos.AggregatedReviewDAO{},
+		questions:         map[string]daos.QuestionDAO{},
+		reviews:           map[string]daos.ReviewDAO{},
+	}
+}
+
+// timestamp returns the current time as Postgres stores a TIMESTAMP column.
+func (s *MemoryStore) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Microsecond)
+}
+
+// LoadSeeds adds the rows of the seed files of fsys, usually seeds.FS.
+// Tables no Memory* repository reads are skipped. Items get the current time
+// as creation time, as the created_at column default does.
+func (s *MemoryStore) LoadSeeds(fsys fs.FS) error {
+	tables, err := seeds.Load(fsys)
+	if err != nil {
+		return err
+	}
+	return s.LoadTables(tables)
+}
+
+// LoadTables adds the rows of tables, such as a synthetic catalog, as
+// LoadSeeds does.
+func (s *MemoryStore) LoadTables(tables []seeds.Table) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, table := range tables {
+		for i, row := range table.Rows {
+			if err := s.loadSeedRow(table.Name, &seedRow{Row: row}); err != nil {
+				return fmt.Errorf("seed %s row %d: %w", table.Name, i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) loadSeedRow(table string, row *seedRow) error {
+	switch table {
+	case "images":
+		s.images[row.String("id")] = daos.ImageDAO{
+			ID:               row.String("id"),
+			URLSmallVersion:  row.String("url_small_version"),
+			URLMediumVersion: row.String("url_medium_version"),
+			Alt:              row.String("alt"),
+		}
+	case "sellers":
+		s.sellers[row.String("seller_id")] = daos.SellerDAO{
+			SellerID:              row.String("seller_id"),
+			Name:                  row.String("name"),
+			Reputation:            row.String("reputation"),
+			NumberOfProducts:      row.int("number_of_products"),
+			NumberOfSales:         row.int("number_of_sales"),
+			NumberOfFollowers:     row.int("number_of_followers"),
+			CategoryDescription:   row.String("category_description"),
+			GeneralRating:         row.float("general_rating"),
+			AttentionDescription:  row.String("attention_description"),
+			PuntualityDescription: row.String("puntuality_description"),
+			ImageID:               row.Row["image_id"],
+		}
+	case "families":
+		s.families[row.String("family_id")] = daos.FamilyDAO{
+			FamilyID: row.String("family_id"),
+			Title:    row.String("title"),
+		}
+	case "prices":
+		s.prices[row.String("id")] = daos.PriceDAO{
+			ID:             row.String("id"),
+			Value:          row.decimal("value"),
+			CurrencySymbol: row.String("currency_symbol"),
+			CurrencyID:     row.String("currency_id"),
+		}
+	case "payment_method_groups":
+		s.paymentGroups[row.String("id")] = true
+	case "payment_methods":
+		s.paymentMethods[row.String("id")] = daos.PaymentMethodDAO{
+			ID:                     row.String("id"),
+			GroupID:                row.String("group_id"),
+			NumberOfInstallments:   row.int("number_of_installments"),
+			InterestRatePercentage: row.float("interest_rate_percentage"),
+			Type:                   daos.PaymentType(row.String("type")),
+			ImageID:                row.Row["image_id"],
+		}
+	case "products":
+		s.products[row.String("id")] = daos.ProductDAO{
+			ID:             row.String("id"),
+			Title:          row.String("title"),
+			Model:          row.String("model"),
+			MainSpec:       row.json("main_spec"),
+			SecondarySpec:  row.json("secondary_spec"),
+			FamilyID:       row.Row["family_id"],
+			PaymentGroupID: row.Row["payment_group_id"],
+		}
+	case "user_products":
+		s.userProducts[row.String("id")] = daos.UserProductDAO{
+			ID:        row.String("id"),
+			ProductID: row.String("product_id"),
+			SellerID:  row.String("seller_id"),
+			SKU:       row.String("sku"),
+		}
+	case "items":
+		createdAt := s.timestamp()
+		if row.Row["created_at"] != nil {
+			createdAt = row.time("created_at")
+		}
+		s.items[row.String("item_id")] = memoryItem{
+			ItemDAO: daos.ItemDAO{
+				ItemID:            row.String("item_id"),
+				UserProductID:     row.String("user_product_id"),
+				Title:             row.String("title"),
+				Description:       row.String("description"),
+				AvailableQuantity: row.int("available_quantity"),
+				ProductStatus:     row.String("product_status"),
+				PriceIDFK:         row.String("price_id_fk"),
+			},
+			CreatedAt: createdAt,
+		}
+	case "item_images":
+		s.itemImages[row.String("id")] = daos.ItemImageDAO{
+			ID:      row.String("id"),
+			ItemID:  row.String("item_id"),
+			ImageID: row.String("image_id"),
+		}
+	case "aggregated_reviews":
+		s.aggregatedReviews[row.String("product_id")] = daos.AggregatedReviewDAO{
+			ID:          row.String("id"),
+			ProductID:   row.String("product_id"),
+			RatingValue: row.float("rating_value"),
+			RatingCount: row.int("rating_count"),
+		}
+	case "questions":
+		question := daos.QuestionDAO{
+			ID:        row.String("id"),
+			ItemID:    row.String("item_id"),
+			Question:  row.String("question"),
+			Answer:    row.String("answer"),
+			CreatedAt: row.time("created_at"),
+		}
+		if row.Row["answered_at"] != nil {
+			question.AnsweredAt = lo.ToPtr(row.time("answered_at"))
+		}
+		s.questions[question.ID] = question
+	case "reviews":
+		s.reviews[row.String("id")] = daos.ReviewDAO{
+			ID:        row.String("id"),
+			ProductID: row.String("product_id"),
+			SellerID:  row.String("seller_id"),
+			ItemID:    row.Row["item_id"],
+			Rating:    row.int("rating"),
+			Content:   row.String("content"),
+			CreatedAt: row.time("created_at"),
+		}
+	}
+	return row.err
+}
+
+// seedRow converts seed values, keeping the first conversion error.
+type seedRow struct {
+	seeds.Row
+	err error
+}
+
+func (r *seedRow) fail(column string, err error) {
+	if r.err == nil {
+		r.err = fmt.Errorf("column %s: %w", column, err)
+	}
+}
+
+func (r *seedRow) int(column string) int {
+	value, err := strconv.Atoi(r.String(column))
+	if err != nil && r.Row[column] != nil {
+		r.fail(column, err)
+	}
+	return value
+}
+
+func (r *seedRow) float(column string) float64 {
+	value, err := strconv.ParseFloat(r.String(column), 64)
+	if err != nil && r.Row[column] != nil {
+		r.fail(column, err)
+	}
+	return value
+}
+
+func (r *seedRow) decimal(column string) decimal.Decimal {
+	value, err := decimal.NewFromString(r.String(column))
+	if err != nil && r.Row[column] != nil {
+		r.fail(column, err)
+	}
+	return value
+}
+
+func (r *seedRow) json(column string) []byte {
+	if r.Row[column] == nil {
+		return nil
+	}
+	return []byte(r.String(column))
+}
+
+// time parses a TIMESTAMP literal, e.g. 2025-08-24 08:13:28.365077, as UTC.
+func (r *seedRow) time(column string) time.Time {
+	value, err := time.Parse("2006-01-02 15:04:05.999999999", r.String(column))
+	if err != nil {
+		r.fail(column, err)
+	}
+	return value
+}
+
+// The helpers below read the store and must be called with mu held.
+
+func (s *MemoryStore) image(id *string) *daos.ImageDAO {
+	if id == nil {
+		return nil
+	}
+	image, ok := s.images[*id]
+	if !ok {
+		return nil
+	}
+	return &image
+}
+
+// userProduct returns the user product with its product and seller, as
+// preloaded by ItemsRepository.GetEnriched.
+func (s *MemoryStore) userProduct(id string) *daos.UserProductDAO {
+	userProduct, ok := s.userProducts[id]
+	if !ok {
+		return nil
+	}
+
+	if product, ok := s.products[userProduct.ProductID]; ok {
+		if product.FamilyID != nil {
+			if family, ok := s.families[*product.FamilyID]; ok {
+				product.Family = &family
+			}
+		}
+		if product.PaymentGroupID != nil && s.paymentGroups[*product.PaymentGroupID] {
+			product.PaymentGroup = &daos.PaymentMethodGroupDAO{
+				ID:             *product.PaymentGroupID,
+				PaymentMethods: s.groupPaymentMethods(*product.PaymentGroupID),
+			}
+		}
+		if aggregatedReview, ok := s.aggregatedReviews[product.ID]; ok {
+			product.AggregatedReview = &aggregatedReview
+		}
+		userProduct.Product = &product
+	}
+
+	if seller, ok := s.sellers[userProduct.SellerID]; ok {
+		seller.Image = s.image(seller.ImageID)
+		userProduct.Seller = &seller
+	}
+
+	return &userProduct
+}
+
+func (s *MemoryStore) groupPaymentMethods(groupID string) daos.PaymentMethodsDAO {
+	var methods daos.PaymentMethodsDAO
+	for _, method := range s.paymentMethods {
+		if method.GroupID == groupID {
+			method.Image = s.image(method.ImageID)
+			methods = append(methods, method)
+		}
+	}
+	slices.SortFunc(methods, func(a, b daos.PaymentMethodDAO) int {
+		return cmp.Or(
+			strings.Compare(string(a.Type), string(b.Type)),
+			-cmp.Compare(a.NumberOfInstallments, b.NumberOfInstallments),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+	return methods
+}
+
+// imagesOf returns the images of the item ordered by item image ID.
+func (s *MemoryStore) imagesOf(itemID string) daos.ItemImagesDAO {
+	var images daos.ItemImagesDAO
+	for _, itemImage := range s.itemImages {
+		if itemImage.ItemID == itemID {
+			itemImage.Image = lo.ToPtr(s.images[itemImage.ImageID])
+			images = append(images, itemImage)
+		}
+	}
+	slices.SortFunc(images, func(a, b daos.ItemImageDAO) int { return strings.Compare(a.ID, b.ID) })
+	return images
+}
+
+// filterReviews returns the reviews matching keep, newest first.
+func (s *MemoryStore) filterReviews(keep func(review daos.ReviewDAO) bool) daos.ReviewsDAO {
+	var reviews daos.ReviewsDAO
+	for _, review := range s.reviews {
+		if keep(review) {
+			reviews = append(reviews, review)
+		}
+	}
+	slices.SortFunc(reviews, compareReviews(domain.ReviewSortNewest))
+	return reviews
+}
+
+// ratingCounts counts the reviews per rating, as ratingDistribution does.
+func ratingCounts(reviews daos.ReviewsDAO) map[int]int {
+	counts := map[int]int{}
+	for _, review := range reviews {
+		counts[review.Rating]++
+	}
+	return counts
+}
+
+// averageRating is ROUND(AVG(rating), 2), or 0 without reviews.
+func averageRating(reviews daos.ReviewsDAO) float64 {
+	if len(reviews) == 0 {
+		return 0
+	}
+	total := 0
+	for _, review := range reviews {
+		total += review.Rating
+	}
+	return math.Round(float64(total)*100/float64(len(reviews))) / 100
+}
+
+func (s *MemoryStore) itemExists(itemID string) error {
+	if _, ok := s.items[itemID]; !ok {
+		return domain.NewNotFound("item not found")
+	}
+	return nil
+}
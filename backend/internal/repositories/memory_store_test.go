@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/db/seed"
+	"meli-backend/internal/db/seeds"
+	"meli-backend/internal/domain"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_LoadSeeds(t *testing.T) {
+	now := time.Date(2025, 8, 24, 9, 0, 0, 0, time.UTC)
+	store := newMemoryStore(func() time.Time { return now })
+
+	require.NoError(t, store.LoadSeeds(seeds.FS))
+
+	assert.Len(t, store.images, 7)
+	assert.Len(t, store.items, 1)
+	assert.Len(t, store.itemImages, 5)
+	assert.Equal(t, now, store.items[seedItemID].CreatedAt)
+	assert.Equal(t, time.Date(2025, 8, 24, 8, 13, 28, 365077000, time.UTC), store.questions[seedQuestionID].CreatedAt)
+	assert.Equal(t, "3137310", store.prices[store.items[seedItemID].PriceIDFK].Value.String())
+	assert.Empty(t, store.items[seedItemID].Description)
+}
+
+func TestMemoryStore_LoadSeeds_InvalidValue(t *testing.T) {
+	store := NewMemoryStore()
+
+	err := store.LoadSeeds(fstest.MapFS{
+		"001_items.sql": {Data: []byte(`INSERT INTO public.items (item_id,available_quantity) VALUES ('1','many');`)},
+	})
+
+	assert.ErrorContains(t, err, "seed items row 1: column available_quantity")
+}
+
+func TestMemoryStore_LoadTables_Synthetic(t *testing.T) {
+	store := NewMemoryStore()
+	require.NoError(t, store.LoadTables(seed.Synthetic(40, 1)))
+	items := NewMemoryItemsRepository(store)
+	sellers := NewMemorySellersRepository(store)
+
+	require.Len(t, store.items, 40)
+	for itemID := range store.items {
+		item, err := items.GetEnriched(context.Background(), itemID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, item.UserProduct.Product.MainSpec)
+		assert.NotEmpty(t, item.ItemImages)
+
+		_, err = sellers.GetProfile(context.Background(), item.UserProduct.Seller.ID)
+		assert.NoError(t, err)
+	}
+}
+
+func TestMemoryStore_ConcurrentAccess(t *testing.T) {
+	store := NewMemoryStore()
+	require.NoError(t, store.LoadSeeds(seeds.FS))
+	questions := NewMemoryQuestionsRepository(store)
+	items := NewMemoryItemsRepository(store)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := questions.Create(context.Background(), seedItemID, "question")
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := items.GetEnriched(context.Background(), seedItemID)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	page, err := questions.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID, Limit: domain.MaxPageLimit})
+	require.NoError(t, err)
+	assert.Len(t, page.Questions, 21)
+}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+
+	"gorm.io/gorm"
+)
+
+type PaymentsRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewPaymentsRepository(dbWrapper *DbWrapper) *PaymentsRepository {
+	return &PaymentsRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// GetItemPaymentTerms loads only the price of the item and the payment
+// methods of its product.
+func (r *PaymentsRepository) GetItemPaymentTerms(ctx context.Context, itemID string) (*domain.ItemPaymentTerms, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "payments", "GetItemPaymentTerms")
+	defer end()
+
+	var item daos.ItemDAO
+	err := r.dbWrapper.DB.WithContext(ctx).
+		Preload("Price").
+		Preload("UserProduct").
+		Preload("UserProduct.Product").
+		Preload("UserProduct.Product.PaymentGroup").
+		Preload("UserProduct.Product.PaymentGroup.PaymentMethods", func(db *gorm.DB) *gorm.DB {
+			return db.Order("type, number_of_installments DESC, id")
+		}).
+		Preload("UserProduct.Product.PaymentGroup.PaymentMethods.Image").
+		Where("item_id = ?", itemID).
+		First(&item).Error
+	if err != nil {
+		return nil, translateError(err, "item")
+	}
+
+	terms := &domain.ItemPaymentTerms{ItemID: item.ItemID}
+	if item.Price != nil {
+		terms.Price = *item.Price.ToDomain()
+	}
+	if item.UserProduct != nil && item.UserProduct.Product != nil && item.UserProduct.Product.PaymentGroup != nil {
+		terms.PaymentGroup = *item.UserProduct.Product.PaymentGroup.ToDomain()
+	}
+	return terms, nil
+}
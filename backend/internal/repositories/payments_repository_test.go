@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentsRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewPaymentsRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestPaymentsRepository_GetItemPaymentTerms_WithNilDB(t *testing.T) {
+	repo := NewPaymentsRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.GetItemPaymentTerms(context.Background(), "test-item-id")
+	})
+}
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	questionSort     = "created_at"
+	questionAnswered = "COALESCE(answer, '') <> ''"
+)
+
+type QuestionsRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewQuestionsRepository(dbWrapper *DbWrapper) *QuestionsRepository {
+	return &QuestionsRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// Create stores a new unanswered question for the item.
+func (r *QuestionsRepository) Create(ctx context.Context, itemID, text string) (*domain.Question, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "questions", "Create")
+	defer end()
+
+	if err := r.ensureItemExists(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	question := daos.QuestionDAO{
+		ID:        uuid.NewString(),
+		ItemID:    itemID,
+		Question:  text,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// answer is left out so it is stored as NULL rather than an empty string
+	if err := r.dbWrapper.DB.WithContext(ctx).Omit("answer", "answered_at").Create(&question).Error; err != nil {
+		return nil, translateError(err, "question")
+	}
+
+	return question.ToDomain(), nil
+}
+
+// Answer sets the answer of a question. Questions can only be answered once.
+func (r *QuestionsRepository) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "questions", "Answer")
+	defer end()
+
+	result := r.dbWrapper.DB.WithContext(ctx).
+		Model(&daos.QuestionDAO{}).
+		Where("id = ?", questionID).
+		Where("NOT (" + questionAnswered + ")").
+		Updates(map[string]interface{}{
+			"answer":      answer,
+			"answered_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return nil, translateError(result.Error, "question")
+	}
+
+	question, err := r.getByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, domain.NewConflict("question is already answered")
+	}
+
+	return question, nil
+}
+
+// ListByItem returns the questions of an item, newest first.
+func (r *QuestionsRepository) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "questions", "ListByItem")
+	defer end()
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, questionSort)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.ensureItemExists(ctx, query.ItemID); err != nil {
+		return nil, err
+	}
+
+	db := r.dbWrapper.DB.WithContext(ctx).Where("item_id = ?", query.ItemID)
+
+	switch query.Status {
+	case domain.QuestionStatusAnswered:
+		db = db.Where(questionAnswered)
+	case domain.QuestionStatusUnanswered:
+		db = db.Where("NOT (" + questionAnswered + ")")
+	}
+
+	if cursor != nil {
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Key)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		db = db.Where("(created_at, id) < (?, ?)", createdAt, cursor.ID)
+	}
+
+	var questions daos.QuestionsDAO
+	err = db.
+		Order("created_at DESC, id DESC").
+		Limit(limit + 1).
+		Find(&questions).Error
+	if err != nil {
+		return nil, translateError(err, "question")
+	}
+
+	return newQuestionPage(questions, limit), nil
+}
+
+// newQuestionPage trims the extra row fetched to detect a next page and builds its cursor.
+func newQuestionPage(questions daos.QuestionsDAO, limit int) *domain.QuestionPage {
+	page := &domain.QuestionPage{}
+	if len(questions) > limit {
+		questions = questions[:limit]
+		last := questions[len(questions)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort: questionSort,
+			Key:  last.CreatedAt.Format(time.RFC3339Nano),
+			ID:   last.ID,
+		})
+	}
+	page.Questions = questions.ToDomain()
+	return page
+}
+
+func (r *QuestionsRepository) getByID(ctx context.Context, questionID string) (*domain.Question, error) {
+	var question daos.QuestionDAO
+	err := r.dbWrapper.DB.WithContext(ctx).Where("id = ?", questionID).First(&question).Error
+	if err != nil {
+		return nil, translateError(err, "question")
+	}
+	return question.ToDomain(), nil
+}
+
+func (r *QuestionsRepository) ensureItemExists(ctx context.Context, itemID string) error {
+	var count int64
+	err := r.dbWrapper.DB.WithContext(ctx).Model(&daos.ItemDAO{}).Where("item_id = ?", itemID).Count(&count).Error
+	if err != nil {
+		return translateError(err, "item")
+	}
+	if count == 0 {
+		return domain.NewNotFound("item not found")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuestionsRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewQuestionsRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestQuestionsRepository_ListByItem_WithInvalidCursor(t *testing.T) {
+	repo := NewQuestionsRepository(&DbWrapper{})
+
+	_, err := repo.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: "test-item-id", Cursor: "not a cursor!"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestQuestionsRepository_Create_WithNilDB(t *testing.T) {
+	repo := NewQuestionsRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.Create(context.Background(), "test-item-id", "Is it available?")
+	})
+}
+
+func TestQuestionsRepository_Answer_WithNilDB(t *testing.T) {
+	repo := NewQuestionsRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.Answer(context.Background(), "test-question-id", "Yes")
+	})
+}
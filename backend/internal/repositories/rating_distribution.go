@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+
+	"gorm.io/gorm"
+)
+
+// ratingDistribution counts the reviews matching the given reviews column
+// (product_id, seller_id...) per star level and turns them into buckets.
+func ratingDistribution(db *gorm.DB, column, id string) ([]domain.RatingBucket, error) {
+	var counts daos.RatingCountsDAO
+	err := db.
+		Model(&daos.ReviewDAO{}).
+		Select("rating, COUNT(*) AS count").
+		Where(column+" = ?", id).
+		Group("rating").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewRatingDistribution(counts.ToMap()), nil
+}
@@ -0,0 +1,262 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewsRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewReviewsRepository(dbWrapper *DbWrapper) *ReviewsRepository {
+	return &ReviewsRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// Create stores a review for the item and recomputes the aggregated review of
+// its product in the same transaction, which also lists the product items. The aggregate row is locked before the
+// review is inserted, so concurrent submissions for the same product are
+// serialized and each recomputation sees every committed review.
+func (r *ReviewsRepository) Create(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "reviews", "Create")
+	defer end()
+
+	var submitted *domain.SubmittedReview
+
+	err := r.dbWrapper.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var userProduct daos.UserProductDAO
+		err := tx.
+			Joins("JOIN items i ON i.user_product_id = user_products.id").
+			Where("i.item_id = ?", review.ItemID).
+			First(&userProduct).Error
+		if err != nil {
+			return translateError(err, "item")
+		}
+
+		if err := r.lockAggregatedReview(tx, userProduct.ProductID); err != nil {
+			return err
+		}
+
+		itemID := review.ItemID
+		reviewDAO := daos.ReviewDAO{
+			ID:        uuid.NewString(),
+			ProductID: userProduct.ProductID,
+			SellerID:  userProduct.SellerID,
+			ItemID:    &itemID,
+			Rating:    review.Rating,
+			Content:   review.Content,
+			CreatedAt: time.Now().UTC(),
+		}
+		if err := tx.Create(&reviewDAO).Error; err != nil {
+			return err
+		}
+
+		aggregatedReview, err := r.recomputeAggregatedReview(tx, userProduct.ProductID)
+		if err != nil {
+			return err
+		}
+
+		var productItemIDs []string
+		err = tx.
+			Table("items i").
+			Joins("JOIN user_products up ON up.id = i.user_product_id").
+			Where("up.product_id = ?", userProduct.ProductID).
+			Order("i.item_id").
+			Pluck("i.item_id", &productItemIDs).Error
+		if err != nil {
+			return err
+		}
+
+		submitted = &domain.SubmittedReview{
+			Review:           *reviewDAO.ToDomain(),
+			AggregatedReview: *aggregatedReview.ToDomain(),
+			ProductItemIDs:   productItemIDs,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, "review")
+	}
+
+	return submitted, nil
+}
+
+// lockAggregatedReview takes a row lock on the product aggregate, creating an
+// empty one first if the product has never been reviewed.
+func (r *ReviewsRepository) lockAggregatedReview(tx *gorm.DB, productID string) error {
+	err := tx.
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "product_id"}}, DoNothing: true}).
+		Create(&daos.AggregatedReviewDAO{
+			ID:        uuid.NewString(),
+			ProductID: productID,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	var aggregatedReview daos.AggregatedReviewDAO
+	return tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id = ?", productID).
+		First(&aggregatedReview).Error
+}
+
+func (r *ReviewsRepository) recomputeAggregatedReview(tx *gorm.DB, productID string) (*daos.AggregatedReviewDAO, error) {
+	var aggregatedReview daos.AggregatedReviewDAO
+	err := tx.Raw(`
+		UPDATE aggregated_reviews ar
+		SET rating_count = stats.rating_count,
+			rating_value = stats.rating_value
+		FROM (
+			SELECT COUNT(*) AS rating_count, COALESCE(ROUND(AVG(rating), 2), 0) AS rating_value
+			FROM reviews
+			WHERE product_id = ?
+		) stats
+		WHERE ar.product_id = ?
+		RETURNING ar.*`, productID, productID).
+		Scan(&aggregatedReview).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &aggregatedReview, nil
+}
+
+// ListByItem returns a page of reviews of the item, or of its whole product
+// when query.Scope is domain.ReviewScopeProduct. Ties on rating are always
+// broken by newest first.
+func (r *ReviewsRepository) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "reviews", "ListByItem")
+	defer end()
+
+	if query.Sort == "" {
+		query.Sort = domain.ReviewSortNewest
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, string(query.Sort))
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := r.scopedReviews(ctx, query.ItemID, query.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Rating != 0 {
+		db = db.Where("rating = ?", query.Rating)
+	}
+
+	if cursor != nil {
+		db, err = applyReviewCursor(db, query.Sort, cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch query.Sort {
+	case domain.ReviewSortHighest:
+		db = db.Order("rating DESC")
+	case domain.ReviewSortLowest:
+		db = db.Order("rating ASC")
+	}
+
+	var reviews daos.ReviewsDAO
+	err = db.
+		Order("created_at DESC, id DESC").
+		Limit(limit + 1).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, translateError(err, "review")
+	}
+
+	return newReviewPage(reviews, query.Sort, limit), nil
+}
+
+// scopedReviews starts a reviews query restricted to the item or to its product.
+func (r *ReviewsRepository) scopedReviews(ctx context.Context, itemID string, scope domain.ReviewScope) (*gorm.DB, error) {
+	var userProduct daos.UserProductDAO
+	err := r.dbWrapper.DB.WithContext(ctx).
+		Joins("JOIN items i ON i.user_product_id = user_products.id").
+		Where("i.item_id = ?", itemID).
+		First(&userProduct).Error
+	if err != nil {
+		return nil, translateError(err, "item")
+	}
+
+	if scope == domain.ReviewScopeProduct {
+		return r.dbWrapper.DB.WithContext(ctx).Where("product_id = ?", userProduct.ProductID), nil
+	}
+	return r.dbWrapper.DB.WithContext(ctx).Where("item_id = ?", itemID), nil
+}
+
+func applyReviewCursor(db *gorm.DB, sort domain.ReviewSort, cursor *pageCursor) (*gorm.DB, error) {
+	rating, createdAt, err := parseReviewCursorKey(cursor.Key)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	switch sort {
+	case domain.ReviewSortHighest:
+		return db.Where("(rating, created_at, id) < (?, ?, ?)", rating, createdAt, cursor.ID), nil
+	case domain.ReviewSortLowest:
+		return db.Where("rating > ? OR (rating = ? AND (created_at, id) < (?, ?))", rating, rating, createdAt, cursor.ID), nil
+	default:
+		return db.Where("(created_at, id) < (?, ?)", createdAt, cursor.ID), nil
+	}
+}
+
+// newReviewPage trims the extra row fetched to detect a next page and builds its cursor.
+func newReviewPage(reviews daos.ReviewsDAO, sort domain.ReviewSort, limit int) *domain.ReviewPage {
+	page := &domain.ReviewPage{}
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+		last := reviews[len(reviews)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort: string(sort),
+			Key:  reviewCursorKey(last),
+			ID:   last.ID,
+		})
+	}
+	page.Reviews = reviews.ToDomain()
+	return page
+}
+
+func reviewCursorKey(review daos.ReviewDAO) string {
+	return fmt.Sprintf("%d|%s", review.Rating, review.CreatedAt.Format(time.RFC3339Nano))
+}
+
+func parseReviewCursorKey(key string) (int, time.Time, error) {
+	rawRating, rawCreatedAt, found := strings.Cut(key, "|")
+	if !found {
+		return 0, time.Time{}, domain.ErrInvalidCursor
+	}
+
+	rating, err := strconv.Atoi(rawRating)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, rawCreatedAt)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return rating, createdAt, nil
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReviewsRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewReviewsRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestReviewsRepository_Create_WithNilDB(t *testing.T) {
+	repo := NewReviewsRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.Create(context.Background(), domain.NewReview{ItemID: "test-item-id", Rating: 5})
+	})
+}
+
+func TestReviewsRepository_ListByItem_WithInvalidCursor(t *testing.T) {
+	repo := NewReviewsRepository(&DbWrapper{})
+
+	_, err := repo.ListByItem(context.Background(), domain.ReviewListQuery{ItemID: "test-item-id", Sort: domain.ReviewSortHighest, Cursor: "not a cursor!"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestReviewCursorKey_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 8, 24, 8, 14, 32, 233993000, time.UTC)
+
+	key := reviewCursorKey(daos.ReviewDAO{Rating: 4, CreatedAt: createdAt})
+	rating, parsedCreatedAt, err := parseReviewCursorKey(key)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, rating)
+	assert.True(t, createdAt.Equal(parsedCreatedAt))
+}
+
+func TestParseReviewCursorKey_Malformed(t *testing.T) {
+	for _, key := range []string{"", "4", "four|2025-08-24T08:14:32Z", "4|yesterday"} {
+		_, _, err := parseReviewCursorKey(key)
+
+		assert.Error(t, err, key)
+	}
+}
+
+func TestNewReviewPage(t *testing.T) {
+	createdAt := time.Date(2025, 8, 24, 8, 0, 0, 0, time.UTC)
+	reviews := daos.ReviewsDAO{
+		{ID: "review-1", Rating: 5, CreatedAt: createdAt},
+		{ID: "review-2", Rating: 4, CreatedAt: createdAt},
+		{ID: "review-3", Rating: 3, CreatedAt: createdAt},
+	}
+
+	page := newReviewPage(reviews, domain.ReviewSortHighest, 2)
+
+	assert.Len(t, page.Reviews, 2)
+	cursor, err := decodeCursor(page.NextCursor, string(domain.ReviewSortHighest))
+	assert.NoError(t, err)
+	assert.Equal(t, "review-2", cursor.ID)
+
+	lastPage := newReviewPage(reviews, domain.ReviewSortHighest, 3)
+
+	assert.Len(t, lastPage.Reviews, 3)
+	assert.Empty(t, lastPage.NextCursor)
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+	"strings"
+)
+
+const (
+	searchSort = "relevance"
+	searchRank = "ts_rank_cd(i.search_vector, q.query)"
+	// the headlines delimit matches with sentinels, turned into <mark> tags
+	// once the rest of the text is escaped
+	highlightSelectors = `StartSel="` + daos.HighlightStart + `", StopSel="` + daos.HighlightStop + `"`
+	headlineOptions    = highlightSelectors + ", MaxWords=35, MinWords=15, MaxFragments=2"
+)
+
+type SearchRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewSearchRepository(dbWrapper *DbWrapper) *SearchRepository {
+	return &SearchRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// Search runs a full-text query over items.search_vector, which indexes the
+// item title and description plus the spec values of its product using the
+// accent-insensitive Spanish configuration es_unaccent.
+func (r *SearchRepository) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "search", "Search")
+	defer end()
+
+	limit := query.Limit
+	if limit <= 0 || limit > domain.MaxPageLimit {
+		limit = domain.DefaultPageLimit
+	}
+
+	cursor, err := decodeCursor(query.Cursor, searchSort)
+	if err != nil {
+		return nil, err
+	}
+
+	db := joinItemSummary(
+		r.dbWrapper.DB.WithContext(ctx).
+			Table("items i").
+			Select(itemSummaryColumns+`,
+				`+searchRank+` AS rank,
+				ts_headline('es_unaccent', i.title, q.query, 'HighlightAll=true, `+highlightSelectors+`') AS title_highlight,
+				ts_headline('es_unaccent', COALESCE(NULLIF(i.description, ''), i.title), q.query, '`+headlineOptions+`') AS snippet`).
+			Joins("CROSS JOIN websearch_to_tsquery('es_unaccent', ?) AS q(query)", strings.TrimSpace(query.Text)),
+	).Where("i.search_vector @@ q.query")
+
+	if cursor != nil {
+		rank, err := parseFloatKey(cursor.Key)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		db = db.Where("("+searchRank+", i.item_id) < (?, ?)", rank, cursor.ID)
+	}
+
+	var rows daos.SearchResultsDAO
+	err = db.
+		Order("rank DESC, i.item_id DESC").
+		Limit(limit + 1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "item")
+	}
+
+	page := &domain.SearchPage{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort: searchSort,
+			Key:  formatFloatKey(last.Rank),
+			ID:   last.ItemID,
+		})
+	}
+	page.Results = rows.ToDomain()
+
+	return page, nil
+}
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewSearchRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestSearchRepository_Search_WithInvalidCursor(t *testing.T) {
+	repo := NewSearchRepository(&DbWrapper{})
+
+	_, err := repo.Search(context.Background(), domain.SearchQuery{Text: "samsung", Cursor: "not a cursor!"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestSearchRepository_Search_WithNilDB(t *testing.T) {
+	repo := NewSearchRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.Search(context.Background(), domain.SearchQuery{Text: "samsung"})
+	})
+}
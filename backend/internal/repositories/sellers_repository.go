@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"meli-backend/internal/domain"
+	daos "meli-backend/internal/repositories/daos"
+)
+
+type SellersRepository struct {
+	dbWrapper *DbWrapper
+}
+
+func NewSellersRepository(dbWrapper *DbWrapper) *SellersRepository {
+	return &SellersRepository{
+		dbWrapper: dbWrapper,
+	}
+}
+
+// sellerStatsQuery counts the seller catalog through user_products and rates
+// the seller from every review left on its sales.
+const sellerStatsQuery = `SELECT
+	(SELECT COUNT(DISTINCT up.product_id) FROM user_products up WHERE up.seller_id = @seller_id) AS products_count,
+	(SELECT COUNT(*) FROM items i JOIN user_products up ON up.id = i.user_product_id
+		WHERE up.seller_id = @seller_id) AS items_count,
+	(SELECT COUNT(*) FROM items i JOIN user_products up ON up.id = i.user_product_id
+		WHERE up.seller_id = @seller_id AND i.available_quantity > 0) AS active_items_count,
+	(SELECT COUNT(*) FROM reviews r WHERE r.seller_id = @seller_id) AS reviews_count,
+	(SELECT COALESCE(ROUND(AVG(r.rating), 2), 0) FROM reviews r WHERE r.seller_id = @seller_id) AS rating_value`
+
+// GetProfile returns the seller with its live counters and rating
+// distribution. The seller items are listed by ItemsRepository.List.
+func (r *SellersRepository) GetProfile(ctx context.Context, sellerID string) (*domain.SellerProfile, error) {
+	ctx, end := r.dbWrapper.startOperation(ctx, "sellers", "GetProfile")
+	defer end()
+
+	var seller daos.SellerDAO
+	err := r.dbWrapper.DB.WithContext(ctx).
+		Preload("Image").
+		Where("seller_id = ?", sellerID).
+		First(&seller).Error
+	if err != nil {
+		return nil, translateError(err, "seller")
+	}
+
+	var stats daos.SellerStatsDAO
+	err = r.dbWrapper.DB.WithContext(ctx).Raw(sellerStatsQuery, sql.Named("seller_id", sellerID)).Scan(&stats).Error
+	if err != nil {
+		return nil, translateError(err, "seller")
+	}
+
+	distribution, err := ratingDistribution(r.dbWrapper.DB.WithContext(ctx), "seller_id", sellerID)
+	if err != nil {
+		return nil, translateError(err, "seller")
+	}
+
+	return &domain.SellerProfile{
+		Seller:             *seller.ToDomain(),
+		Stats:              *stats.ToDomain(),
+		RatingDistribution: distribution,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSellersRepository(t *testing.T) {
+	mockDbWrapper := &DbWrapper{}
+	repo := NewSellersRepository(mockDbWrapper)
+
+	assert.NotNil(t, repo)
+	assert.Equal(t, mockDbWrapper, repo.dbWrapper)
+}
+
+func TestSellersRepository_GetProfile_WithNilDB(t *testing.T) {
+	repo := NewSellersRepository(&DbWrapper{DB: nil})
+
+	assert.Panics(t, func() {
+		repo.GetProfile(context.Background(), "test-seller-id")
+	})
+}
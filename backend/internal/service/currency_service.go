@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/logging"
+)
+
+type ExchangeRatesRepositoryInterface interface {
+	Get(ctx context.Context, base, quote string) (*domain.ExchangeRate, error)
+}
+
+type CurrencyService struct {
+	exchangeRatesRepository ExchangeRatesRepositoryInterface
+}
+
+func NewCurrencyService(exchangeRatesRepository ExchangeRatesRepositoryInterface) *CurrencyService {
+	return &CurrencyService{exchangeRatesRepository: exchangeRatesRepository}
+}
+
+// Rate returns the rate converting from into to. It uses the stored pair, its
+// inverse, or a cross rate through domain.PivotCurrency, in that order, and
+// fails with domain.ErrUnsupportedCurrency when none is available.
+func (s *CurrencyService) Rate(ctx context.Context, from, to string) (*domain.ExchangeRate, error) {
+	rate, err := s.rate(ctx, from, to)
+	if errors.Is(err, domain.ErrUnsupportedCurrency) {
+		return nil, &domain.Error{
+			Kind:    domain.ErrInvalidArgument,
+			Message: fmt.Sprintf("no exchange rate from %s to %s", from, to),
+			Err:     domain.ErrUnsupportedCurrency,
+		}
+	}
+	return rate, err
+}
+
+func (s *CurrencyService) rate(ctx context.Context, from, to string) (*domain.ExchangeRate, error) {
+	rate, err := s.storedRate(ctx, from, to)
+	if err == nil || !errors.Is(err, domain.ErrUnsupportedCurrency) {
+		return rate, err
+	}
+	if from == domain.PivotCurrency || to == domain.PivotCurrency {
+		return nil, err
+	}
+
+	toPivot, err := s.storedRate(ctx, from, domain.PivotCurrency)
+	if err != nil {
+		return nil, err
+	}
+	fromPivot, err := s.storedRate(ctx, domain.PivotCurrency, to)
+	if err != nil {
+		return nil, err
+	}
+
+	cross := toPivot.Compose(*fromPivot)
+	logging.FromContext(ctx).Debug("using cross exchange rate",
+		"from", from,
+		"to", to,
+		"pivot", domain.PivotCurrency,
+	)
+	return &cross, nil
+}
+
+func (s *CurrencyService) storedRate(ctx context.Context, from, to string) (*domain.ExchangeRate, error) {
+	rate, err := s.exchangeRatesRepository.Get(ctx, from, to)
+	if err == nil {
+		return rate, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	rate, err = s.exchangeRatesRepository.Get(ctx, to, from)
+	if err == nil {
+		inverse := rate.Invert()
+		return &inverse, nil
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrUnsupportedCurrency
+	}
+	return nil, err
+}
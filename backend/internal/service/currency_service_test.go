@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExchangeRatesRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRatesRepository) Get(ctx context.Context, base, quote string) (*domain.ExchangeRate, error) {
+	args := m.Called(base, quote)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExchangeRate), args.Error(1)
+}
+
+type MockCurrencyConverter struct {
+	mock.Mock
+}
+
+func (m *MockCurrencyConverter) Rate(ctx context.Context, from, to string) (*domain.ExchangeRate, error) {
+	args := m.Called(from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExchangeRate), args.Error(1)
+}
+
+func usdTo(quote, rate string) *domain.ExchangeRate {
+	return &domain.ExchangeRate{BaseCurrency: "USD", QuoteCurrency: quote, Rate: decimal.RequireFromString(rate)}
+}
+
+func TestNewCurrencyService(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockRepo, service.exchangeRatesRepository)
+}
+
+func TestCurrencyService_Rate_Stored(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	mockRepo.On("Get", "USD", "COP").Return(usdTo("COP", "4000"), nil)
+
+	result, err := service.Rate(context.Background(), "USD", "COP")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "4000", result.Rate.String())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyService_Rate_Inverse(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	mockRepo.On("Get", "COP", "USD").Return(nil, domain.ErrNotFound)
+	mockRepo.On("Get", "USD", "COP").Return(usdTo("COP", "4000"), nil)
+
+	result, err := service.Rate(context.Background(), "COP", "USD")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "COP", result.BaseCurrency)
+	assert.Equal(t, "USD", result.QuoteCurrency)
+	assert.Equal(t, "0.00025", result.Rate.String())
+}
+
+func TestCurrencyService_Rate_CrossThroughPivot(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	mockRepo.On("Get", "COP", "ARS").Return(nil, domain.ErrNotFound)
+	mockRepo.On("Get", "ARS", "COP").Return(nil, domain.ErrNotFound)
+	mockRepo.On("Get", "COP", "USD").Return(nil, domain.ErrNotFound)
+	mockRepo.On("Get", "USD", "COP").Return(usdTo("COP", "4000"), nil)
+	mockRepo.On("Get", "USD", "ARS").Return(usdTo("ARS", "1330"), nil)
+
+	result, err := service.Rate(context.Background(), "COP", "ARS")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "COP", result.BaseCurrency)
+	assert.Equal(t, "ARS", result.QuoteCurrency)
+	assert.Equal(t, "0.3325", result.Rate.String())
+}
+
+func TestCurrencyService_Rate_Unsupported(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	mockRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	result, err := service.Rate(context.Background(), "USD", "XYZ")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrUnsupportedCurrency)
+	assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	assert.Equal(t, "no exchange rate from USD to XYZ", domain.ErrorMessage(err))
+}
+
+func TestCurrencyService_Rate_RepositoryError(t *testing.T) {
+	mockRepo := &MockExchangeRatesRepository{}
+	service := NewCurrencyService(mockRepo)
+
+	mockRepo.On("Get", "USD", "COP").Return(nil, errors.New("database error"))
+
+	result, err := service.Rate(context.Background(), "USD", "COP")
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "database error")
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+type FamiliesRepositoryInterface interface {
+	GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error)
+}
+
+type FamilyService struct {
+	familiesRepository FamiliesRepositoryInterface
+}
+
+func NewFamilyService(familiesRepository FamiliesRepositoryInterface) *FamilyService {
+	return &FamilyService{familiesRepository: familiesRepository}
+}
+
+// GetItems returns every sibling item of a family. Each sibling carries the
+// main spec attributes whose value changes across the family (color,
+// storage...), which is what a variant picker needs to render.
+func (s *FamilyService) GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error) {
+	familyItems, err := s.familiesRepository.GetItems(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	familyItems.Options = s.variantOptions(familyItems.Variants)
+
+	names := lo.Map(familyItems.Options, func(option domain.VariantOption, _ int) string {
+		return option.Name
+	})
+	for i := range familyItems.Variants {
+		familyItems.Variants[i].Attributes = s.variantAttributes(familyItems.Variants[i], names)
+	}
+
+	return familyItems, nil
+}
+
+// variantOptions collects, in order of first appearance, the attributes that
+// take more than one value across the variants.
+func (s *FamilyService) variantOptions(variants []domain.FamilyVariant) []domain.VariantOption {
+	var names []string
+	values := map[string][]string{}
+
+	for _, variant := range variants {
+		for _, spec := range variant.MainSpec {
+			if spec.Value == "" {
+				continue
+			}
+			if _, seen := values[spec.Item]; !seen {
+				names = append(names, spec.Item)
+			}
+			if !lo.Contains(values[spec.Item], spec.Value) {
+				values[spec.Item] = append(values[spec.Item], spec.Value)
+			}
+		}
+	}
+
+	options := []domain.VariantOption{}
+	for _, name := range names {
+		if len(values[name]) > 1 {
+			options = append(options, domain.VariantOption{Name: name, Values: values[name]})
+		}
+	}
+	return options
+}
+
+func (s *FamilyService) variantAttributes(variant domain.FamilyVariant, names []string) []domain.VariantAttribute {
+	attributes := []domain.VariantAttribute{}
+	for _, name := range names {
+		spec, found := lo.Find(variant.MainSpec, func(spec domain.MainSpecItem) bool {
+			return spec.Item == name
+		})
+		if found {
+			attributes = append(attributes, domain.VariantAttribute{Name: name, Value: spec.Value})
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFamiliesRepository struct {
+	mock.Mock
+}
+
+func (m *MockFamiliesRepository) GetItems(ctx context.Context, familyID string) (*domain.FamilyItems, error) {
+	args := m.Called(familyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.FamilyItems), args.Error(1)
+}
+
+func TestFamilyService_GetItems_DistinguishingAttributes(t *testing.T) {
+	mockRepo := &MockFamiliesRepository{}
+	service := NewFamilyService(mockRepo)
+
+	mockRepo.On("GetItems", "family-id").Return(&domain.FamilyItems{
+		Family: domain.Family{ID: "family-id", Title: "Celulares y Smartphones"},
+		Variants: []domain.FamilyVariant{
+			{
+				Item: domain.ItemSummary{ID: "item-1"},
+				MainSpec: []domain.MainSpecItem{
+					{Item: "Memoria interna", Value: "256 GB"},
+					{Item: "Color", Value: "Light Pink"},
+					{Item: "Marca", Value: "Samsung"},
+				},
+			},
+			{
+				Item: domain.ItemSummary{ID: "item-2"},
+				MainSpec: []domain.MainSpecItem{
+					{Item: "Memoria interna", Value: "512 GB"},
+					{Item: "Color", Value: "Light Pink"},
+					{Item: "Marca", Value: "Samsung"},
+				},
+			},
+			{
+				Item: domain.ItemSummary{ID: "item-3"},
+				MainSpec: []domain.MainSpecItem{
+					{Item: "Memoria interna", Value: "256 GB"},
+					{Item: "Color", Value: "Onyx Black"},
+					{Item: "Marca", Value: "Samsung"},
+				},
+			},
+		},
+	}, nil)
+
+	result, err := service.GetItems(context.Background(), "family-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.VariantOption{
+		{Name: "Memoria interna", Values: []string{"256 GB", "512 GB"}},
+		{Name: "Color", Values: []string{"Light Pink", "Onyx Black"}},
+	}, result.Options)
+	assert.Equal(t, []domain.VariantAttribute{
+		{Name: "Memoria interna", Value: "512 GB"},
+		{Name: "Color", Value: "Light Pink"},
+	}, result.Variants[1].Attributes)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFamilyService_GetItems_SingleVariant(t *testing.T) {
+	mockRepo := &MockFamiliesRepository{}
+	service := NewFamilyService(mockRepo)
+
+	mockRepo.On("GetItems", "family-id").Return(&domain.FamilyItems{
+		Variants: []domain.FamilyVariant{
+			{MainSpec: []domain.MainSpecItem{{Item: "Color", Value: "Light Pink"}}},
+		},
+	}, nil)
+
+	result, err := service.GetItems(context.Background(), "family-id")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Options)
+	assert.Empty(t, result.Variants[0].Attributes)
+}
+
+func TestFamilyService_GetItems_Error(t *testing.T) {
+	mockRepo := &MockFamiliesRepository{}
+	service := NewFamilyService(mockRepo)
+
+	mockRepo.On("GetItems", "missing").Return(nil, domain.ErrNotFound)
+
+	result, err := service.GetItems(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, result)
+}
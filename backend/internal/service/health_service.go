@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"meli-backend/internal/domain"
+	"strconv"
+	"time"
+)
+
+const (
+	// healthCheckTimeout bounds each check, so a hung database makes the
+	// probe fail instead of timing out.
+	healthCheckTimeout = 2 * time.Second
+	// poolSaturationThreshold is the share of the pool in use above which
+	// the server stops reporting ready, so traffic goes to other instances.
+	poolSaturationThreshold = 0.9
+)
+
+type HealthRepositoryInterface interface {
+	Ping(ctx context.Context) error
+	PoolStats() (domain.PoolStats, error)
+	MigrationVersion(ctx context.Context) (string, error)
+}
+
+type HealthService struct {
+	healthRepository  HealthRepositoryInterface
+	expectedMigration string
+}
+
+// NewHealthService returns a service whose readiness requires the database
+// schema to be at least at expectedMigration, the newest migration shipped
+// with the binary. An empty expectedMigration accepts any applied version.
+func NewHealthService(healthRepository HealthRepositoryInterface, expectedMigration string) *HealthService {
+	return &HealthService{
+		healthRepository:  healthRepository,
+		expectedMigration: expectedMigration,
+	}
+}
+
+// Check runs every dependency check. The report is up only when all of them
+// are: the database answers, its pool is not saturated and its schema is
+// migrated.
+func (s *HealthService) Check(ctx context.Context) domain.HealthReport {
+	report := domain.HealthReport{
+		Status: domain.HealthStatusUp,
+		Dependencies: []domain.DependencyHealth{
+			s.run(ctx, "database", s.checkDatabase),
+			s.run(ctx, "database_pool", s.checkPool),
+			s.run(ctx, "migrations", s.checkMigrations),
+		},
+	}
+	for _, dependency := range report.Dependencies {
+		if dependency.Status != domain.HealthStatusUp {
+			report.Status = domain.HealthStatusDown
+		}
+	}
+	return report
+}
+
+func (s *HealthService) run(ctx context.Context, name string, check func(context.Context) (map[string]interface{}, error)) domain.DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := check(ctx)
+	dependency := domain.DependencyHealth{
+		Name:    name,
+		Status:  domain.HealthStatusUp,
+		Latency: time.Since(start),
+		Details: details,
+	}
+	if err != nil {
+		dependency.Status = domain.HealthStatusDown
+		dependency.Error = err.Error()
+	}
+	return dependency
+}
+
+func (s *HealthService) checkDatabase(ctx context.Context) (map[string]interface{}, error) {
+	return nil, s.healthRepository.Ping(ctx)
+}
+
+func (s *HealthService) checkPool(ctx context.Context) (map[string]interface{}, error) {
+	stats, err := s.healthRepository.PoolStats()
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]interface{}{
+		"maxOpen":        stats.MaxOpenConnections,
+		"open":           stats.OpenConnections,
+		"inUse":          stats.InUse,
+		"idle":           stats.Idle,
+		"waitCount":      stats.WaitCount,
+		"waitDurationMs": stats.WaitDuration.Milliseconds(),
+	}
+	if stats.MaxOpenConnections > 0 &&
+		float64(stats.InUse) >= poolSaturationThreshold*float64(stats.MaxOpenConnections) {
+		return details, fmt.Errorf("pool saturated: %d of %d connections in use", stats.InUse, stats.MaxOpenConnections)
+	}
+	return details, nil
+}
+
+func (s *HealthService) checkMigrations(ctx context.Context) (map[string]interface{}, error) {
+	version, err := s.healthRepository.MigrationVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]interface{}{"version": version}
+	if s.expectedMigration != "" {
+		details["expected"] = s.expectedMigration
+		if migrationBefore(version, s.expectedMigration) {
+			return details, fmt.Errorf("schema at version %s, expected %s", version, s.expectedMigration)
+		}
+	}
+	return details, nil
+}
+
+// migrationBefore compares dbmate versions numerically, so "6" and "006" are
+// the same version. A newer schema than expected is accepted, as happens
+// while an older instance is still running during a deploy.
+func migrationBefore(version, expected string) bool {
+	v, errV := strconv.ParseInt(version, 10, 64)
+	e, errE := strconv.ParseInt(expected, 10, 64)
+	if errV != nil || errE != nil {
+		return version < expected
+	}
+	return v < e
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHealthRepository struct {
+	mock.Mock
+}
+
+func (m *MockHealthRepository) Ping(ctx context.Context) error {
+	return m.Called().Error(0)
+}
+
+func (m *MockHealthRepository) PoolStats() (domain.PoolStats, error) {
+	args := m.Called()
+	return args.Get(0).(domain.PoolStats), args.Error(1)
+}
+
+func (m *MockHealthRepository) MigrationVersion(ctx context.Context) (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func dependency(report domain.HealthReport, name string) domain.DependencyHealth {
+	for _, dependency := range report.Dependencies {
+		if dependency.Name == name {
+			return dependency
+		}
+	}
+	return domain.DependencyHealth{}
+}
+
+func TestHealthService_Check_Up(t *testing.T) {
+	mockRepo := &MockHealthRepository{}
+	mockRepo.On("Ping").Return(nil)
+	mockRepo.On("PoolStats").Return(domain.PoolStats{MaxOpenConnections: 10, InUse: 2, Idle: 3}, nil)
+	mockRepo.On("MigrationVersion").Return("006", nil)
+
+	report := NewHealthService(mockRepo, "006").Check(context.Background())
+
+	assert.Equal(t, domain.HealthStatusUp, report.Status)
+	assert.Len(t, report.Dependencies, 3)
+	assert.Equal(t, 2, dependency(report, "database_pool").Details["inUse"])
+	assert.Equal(t, "006", dependency(report, "migrations").Details["version"])
+}
+
+func TestHealthService_Check_DatabaseDown(t *testing.T) {
+	mockRepo := &MockHealthRepository{}
+	mockRepo.On("Ping").Return(errors.New("connection refused"))
+	mockRepo.On("PoolStats").Return(domain.PoolStats{MaxOpenConnections: 10}, nil)
+	mockRepo.On("MigrationVersion").Return("", domain.NewUnavailable("database unavailable", nil))
+
+	report := NewHealthService(mockRepo, "006").Check(context.Background())
+
+	assert.Equal(t, domain.HealthStatusDown, report.Status)
+	assert.Equal(t, domain.HealthStatusDown, dependency(report, "database").Status)
+	assert.Equal(t, "connection refused", dependency(report, "database").Error)
+	assert.Equal(t, domain.HealthStatusUp, dependency(report, "database_pool").Status)
+}
+
+func TestHealthService_Check_PoolSaturated(t *testing.T) {
+	mockRepo := &MockHealthRepository{}
+	mockRepo.On("Ping").Return(nil)
+	mockRepo.On("PoolStats").Return(domain.PoolStats{MaxOpenConnections: 10, InUse: 9}, nil)
+	mockRepo.On("MigrationVersion").Return("006", nil)
+
+	report := NewHealthService(mockRepo, "006").Check(context.Background())
+
+	assert.Equal(t, domain.HealthStatusDown, report.Status)
+	assert.Contains(t, dependency(report, "database_pool").Error, "9 of 10")
+}
+
+func TestHealthService_Check_Migrations(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+		status   domain.HealthStatus
+	}{
+		{"current", "006", "006", domain.HealthStatusUp},
+		{"pending", "005", "006", domain.HealthStatusDown},
+		{"newer schema", "007", "006", domain.HealthStatusUp},
+		{"different width", "6", "006", domain.HealthStatusUp},
+		{"no expectation", "001", "", domain.HealthStatusUp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockHealthRepository{}
+			mockRepo.On("Ping").Return(nil)
+			mockRepo.On("PoolStats").Return(domain.PoolStats{}, nil)
+			mockRepo.On("MigrationVersion").Return(tt.version, nil)
+
+			report := NewHealthService(mockRepo, tt.expected).Check(context.Background())
+
+			assert.Equal(t, tt.status, dependency(report, "migrations").Status)
+			assert.Equal(t, tt.status, report.Status)
+		})
+	}
+}
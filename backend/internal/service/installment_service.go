@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type PaymentsRepositoryInterface interface {
+	GetItemPaymentTerms(ctx context.Context, itemID string) (*domain.ItemPaymentTerms, error)
+}
+
+type InstallmentService struct {
+	paymentsRepository PaymentsRepositoryInterface
+	currencyConverter  CurrencyConverterInterface
+}
+
+func NewInstallmentService(paymentsRepository PaymentsRepositoryInterface, currencyConverter CurrencyConverterInterface) *InstallmentService {
+	return &InstallmentService{
+		paymentsRepository: paymentsRepository,
+		currencyConverter:  currencyConverter,
+	}
+}
+
+// GetByItem computes every installment plan available to pay the item. When
+// currency is set the price is converted before computing the plans, so
+// installment amounts are rounded in the target currency.
+func (s *InstallmentService) GetByItem(ctx context.Context, itemID, currency string) (*domain.ItemInstallments, error) {
+	terms, err := s.paymentsRepository.GetItemPaymentTerms(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	price, rate, err := newPriceConverter(s.currencyConverter, currency).convert(ctx, terms.Price)
+	if err != nil {
+		return nil, err
+	}
+	terms.Price = price
+
+	installments := domain.NewItemInstallments(*terms)
+	installments.ExchangeRate = rate
+	return &installments, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPaymentsRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentsRepository) GetItemPaymentTerms(ctx context.Context, itemID string) (*domain.ItemPaymentTerms, error) {
+	args := m.Called(itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemPaymentTerms), args.Error(1)
+}
+
+func TestNewInstallmentService(t *testing.T) {
+	mockRepo := &MockPaymentsRepository{}
+	service := NewInstallmentService(mockRepo, &MockCurrencyConverter{})
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockRepo, service.paymentsRepository)
+	assert.NotNil(t, service.currencyConverter)
+}
+
+func TestInstallmentService_GetByItem_Success(t *testing.T) {
+	mockRepo := &MockPaymentsRepository{}
+	service := NewInstallmentService(mockRepo, &MockCurrencyConverter{})
+
+	mockRepo.On("GetItemPaymentTerms", "item-id").Return(&domain.ItemPaymentTerms{
+		ItemID: "item-id",
+		Price:  domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(1200), "COP")},
+		PaymentGroup: domain.PaymentGroup{PaymentMethods: []domain.PaymentMethod{
+			{ID: "visa", Type: "credit", NumberOfInstallments: 12},
+		}},
+	}, nil)
+
+	result, err := service.GetByItem(context.Background(), "item-id", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "item-id", result.ItemID)
+	assert.Len(t, result.PaymentMethods, 1)
+	assert.Len(t, result.PaymentMethods[0].Plans, 12)
+	assert.Equal(t, 12, result.Best.Installments)
+	assert.Equal(t, "100.00 COP", result.Best.InstallmentAmount.String())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestInstallmentService_GetByItem_NotFound(t *testing.T) {
+	mockRepo := &MockPaymentsRepository{}
+	service := NewInstallmentService(mockRepo, &MockCurrencyConverter{})
+
+	mockRepo.On("GetItemPaymentTerms", "missing").Return(nil, domain.ErrNotFound)
+
+	result, err := service.GetByItem(context.Background(), "missing", "")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestInstallmentService_GetByItem_ConvertsPrice(t *testing.T) {
+	mockRepo := &MockPaymentsRepository{}
+	mockConverter := &MockCurrencyConverter{}
+	service := NewInstallmentService(mockRepo, mockConverter)
+
+	rate := &domain.ExchangeRate{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025")}
+
+	mockRepo.On("GetItemPaymentTerms", "item-id").Return(&domain.ItemPaymentTerms{
+		ItemID: "item-id",
+		Price:  domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(48000), "COP")},
+		PaymentGroup: domain.PaymentGroup{PaymentMethods: []domain.PaymentMethod{
+			{ID: "visa", Type: "credit", NumberOfInstallments: 12},
+		}},
+	}, nil)
+	mockConverter.On("Rate", "COP", "USD").Return(rate, nil)
+
+	result, err := service.GetByItem(context.Background(), "item-id", "USD")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "12.00 USD", result.Price.Amount.String())
+	assert.Equal(t, "1.00 USD", result.Best.InstallmentAmount.String())
+	assert.Equal(t, rate, result.ExchangeRate)
+}
@@ -1,21 +1,62 @@
 package service
 
 import (
+	"context"
 	"meli-backend/internal/domain"
 )
 
-type ItemServiceInterface interface {
-	GetEnriched(itemID string) (*domain.Item, error)
+type ItemsRepositoryInterface interface {
+	GetEnriched(ctx context.Context, itemID string) (*domain.Item, error)
+	List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error)
+}
+
+// ItemCacheInvalidator drops a cached item, e.g. *cache.Items. Services
+// changing what GetEnriched returns call it after a successful write.
+type ItemCacheInvalidator interface {
+	Invalidate(itemID string) bool
 }
 
 type ItemService struct {
-	itemsRepository ItemServiceInterface
+	itemsRepository   ItemsRepositoryInterface
+	currencyConverter CurrencyConverterInterface
 }
 
-func NewItemService(itemsRepository ItemServiceInterface) *ItemService {
-	return &ItemService{itemsRepository: itemsRepository}
+func NewItemService(itemsRepository ItemsRepositoryInterface, currencyConverter CurrencyConverterInterface) *ItemService {
+	return &ItemService{
+		itemsRepository:   itemsRepository,
+		currencyConverter: currencyConverter,
+	}
 }
 
-func (s *ItemService) GetEnriched(itemID string) (*domain.Item, error) {
-	return s.itemsRepository.GetEnriched(itemID)
+// GetEnriched returns the item with its price converted to currency, unless
+// currency is empty.
+func (s *ItemService) GetEnriched(ctx context.Context, itemID, currency string) (*domain.Item, error) {
+	item, err := s.itemsRepository.GetEnriched(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Price, item.ExchangeRate, err = newPriceConverter(s.currencyConverter, currency).convert(ctx, item.Price)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *ItemService) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	page, err := s.itemsRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := newPriceConverter(s.currencyConverter, query.Currency)
+	for i := range page.Items {
+		page.Items[i].Price, _, err = converter.convert(ctx, page.Items[i].Price)
+		if err != nil {
+			return nil, err
+		}
+	}
+	page.ExchangeRates = converter.used
+
+	return page, nil
 }
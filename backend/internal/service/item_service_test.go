@@ -1,10 +1,12 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"meli-backend/internal/domain"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -13,7 +15,7 @@ type MockItemsRepository struct {
 	mock.Mock
 }
 
-func (m *MockItemsRepository) GetEnriched(itemID string) (*domain.Item, error) {
+func (m *MockItemsRepository) GetEnriched(ctx context.Context, itemID string) (*domain.Item, error) {
 	args := m.Called(itemID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -21,17 +23,26 @@ func (m *MockItemsRepository) GetEnriched(itemID string) (*domain.Item, error) {
 	return args.Get(0).(*domain.Item), args.Error(1)
 }
 
+func (m *MockItemsRepository) List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ItemSummaryPage), args.Error(1)
+}
+
 func TestNewItemService(t *testing.T) {
 	mockRepo := &MockItemsRepository{}
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, &MockCurrencyConverter{})
 
 	assert.NotNil(t, service)
 	assert.Equal(t, mockRepo, service.itemsRepository)
+	assert.NotNil(t, service.currencyConverter)
 }
 
 func TestItemService_GetEnriched_Success(t *testing.T) {
 	mockRepo := &MockItemsRepository{}
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, &MockCurrencyConverter{})
 
 	expectedItem := &domain.Item{
 		ID:    "test-id",
@@ -40,7 +51,7 @@ func TestItemService_GetEnriched_Success(t *testing.T) {
 
 	mockRepo.On("GetEnriched", "test-id").Return(expectedItem, nil)
 
-	result, err := service.GetEnriched("test-id")
+	result, err := service.GetEnriched(context.Background(), "test-id", "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedItem, result)
@@ -49,16 +60,131 @@ func TestItemService_GetEnriched_Success(t *testing.T) {
 
 func TestItemService_GetEnriched_Error(t *testing.T) {
 	mockRepo := &MockItemsRepository{}
-	service := NewItemService(mockRepo)
+	service := NewItemService(mockRepo, &MockCurrencyConverter{})
 
 	expectedError := errors.New("database error")
 
 	mockRepo.On("GetEnriched", "test-id").Return(nil, expectedError)
 
-	result, err := service.GetEnriched("test-id")
+	result, err := service.GetEnriched(context.Background(), "test-id", "")
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Equal(t, expectedError, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestItemService_List_Success(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	service := NewItemService(mockRepo, &MockCurrencyConverter{})
+
+	query := domain.ItemListQuery{Sort: domain.ItemSortPriceAsc, Limit: 10}
+	expectedPage := &domain.ItemSummaryPage{
+		Items:      []domain.ItemSummary{{ID: "test-id", Title: "Test Item"}},
+		NextCursor: "next",
+	}
+
+	mockRepo.On("List", query).Return(expectedPage, nil)
+
+	result, err := service.List(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPage, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestItemService_List_Error(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	service := NewItemService(mockRepo, &MockCurrencyConverter{})
+
+	query := domain.ItemListQuery{Cursor: "bad"}
+
+	mockRepo.On("List", query).Return(nil, domain.ErrInvalidCursor)
+
+	result, err := service.List(context.Background(), query)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestItemService_GetEnriched_ConvertsPrice(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	mockConverter := &MockCurrencyConverter{}
+	service := NewItemService(mockRepo, mockConverter)
+
+	rate := &domain.ExchangeRate{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025")}
+
+	mockRepo.On("GetEnriched", "test-id").Return(&domain.Item{
+		ID:    "test-id",
+		Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(4000), "COP"), CurrencySymbol: "$"},
+	}, nil)
+	mockConverter.On("Rate", "COP", "USD").Return(rate, nil)
+
+	result, err := service.GetEnriched(context.Background(), "test-id", "USD")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1.00 USD", result.Price.Amount.String())
+	assert.Equal(t, "US$", result.Price.CurrencySymbol)
+	assert.Equal(t, rate, result.ExchangeRate)
+	mockConverter.AssertExpectations(t)
+}
+
+func TestItemService_GetEnriched_SameCurrency(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	mockConverter := &MockCurrencyConverter{}
+	service := NewItemService(mockRepo, mockConverter)
+
+	mockRepo.On("GetEnriched", "test-id").Return(&domain.Item{
+		Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(4000), "COP")},
+	}, nil)
+
+	result, err := service.GetEnriched(context.Background(), "test-id", "COP")
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.ExchangeRate)
+	mockConverter.AssertNotCalled(t, "Rate", mock.Anything, mock.Anything)
+}
+
+func TestItemService_GetEnriched_UnsupportedCurrency(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	mockConverter := &MockCurrencyConverter{}
+	service := NewItemService(mockRepo, mockConverter)
+
+	mockRepo.On("GetEnriched", "test-id").Return(&domain.Item{
+		Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(4000), "COP")},
+	}, nil)
+	mockConverter.On("Rate", "COP", "XYZ").Return(nil, domain.ErrUnsupportedCurrency)
+
+	result, err := service.GetEnriched(context.Background(), "test-id", "XYZ")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrUnsupportedCurrency)
+}
+
+func TestItemService_List_ConvertsPrices(t *testing.T) {
+	mockRepo := &MockItemsRepository{}
+	mockConverter := &MockCurrencyConverter{}
+	service := NewItemService(mockRepo, mockConverter)
+
+	query := domain.ItemListQuery{Currency: "USD"}
+	rate := &domain.ExchangeRate{BaseCurrency: "COP", QuoteCurrency: "USD", Rate: decimal.RequireFromString("0.00025")}
+
+	mockRepo.On("List", query).Return(&domain.ItemSummaryPage{
+		Items: []domain.ItemSummary{
+			{ID: "a", Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(4000), "COP")}},
+			{ID: "b", Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(8000), "COP")}},
+			{ID: "c", Price: domain.Price{Amount: domain.NewMoney(decimal.NewFromInt(5), "USD")}},
+		},
+	}, nil)
+	mockConverter.On("Rate", "COP", "USD").Return(rate, nil).Once()
+
+	result, err := service.List(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1.00 USD", result.Items[0].Price.Amount.String())
+	assert.Equal(t, "2.00 USD", result.Items[1].Price.Amount.String())
+	assert.Equal(t, "5.00 USD", result.Items[2].Price.Amount.String())
+	assert.Equal(t, []domain.ExchangeRate{*rate}, result.ExchangeRates)
+	mockConverter.AssertExpectations(t)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type CurrencyConverterInterface interface {
+	Rate(ctx context.Context, from, to string) (*domain.ExchangeRate, error)
+}
+
+// priceConverter converts the prices of a single response to one currency,
+// looking up each source currency rate only once.
+type priceConverter struct {
+	converter CurrencyConverterInterface
+	currency  string
+	rates     map[string]*domain.ExchangeRate
+	used      []domain.ExchangeRate
+}
+
+func newPriceConverter(converter CurrencyConverterInterface, currency string) *priceConverter {
+	return &priceConverter{
+		converter: converter,
+		currency:  currency,
+		rates:     map[string]*domain.ExchangeRate{},
+	}
+}
+
+// convert returns the price in the target currency and the rate applied, which
+// is nil when no conversion was needed.
+func (c *priceConverter) convert(ctx context.Context, price domain.Price) (domain.Price, *domain.ExchangeRate, error) {
+	from := price.Amount.Currency
+	if c.currency == "" || from == c.currency {
+		return price, nil, nil
+	}
+
+	rate, ok := c.rates[from]
+	if !ok {
+		var err error
+		rate, err = c.converter.Rate(ctx, from, c.currency)
+		if err != nil {
+			return price, nil, err
+		}
+		c.rates[from] = rate
+		c.used = append(c.used, *rate)
+	}
+
+	return rate.Convert(price), rate, nil
+}
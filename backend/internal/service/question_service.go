@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type QuestionsRepositoryInterface interface {
+	Create(ctx context.Context, itemID, question string) (*domain.Question, error)
+	Answer(ctx context.Context, questionID, answer string) (*domain.Question, error)
+	ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error)
+}
+
+type QuestionService struct {
+	questionsRepository QuestionsRepositoryInterface
+	itemCache           ItemCacheInvalidator
+}
+
+// NewQuestionService creates the service. itemCache may be nil when the item
+// cache is disabled.
+func NewQuestionService(questionsRepository QuestionsRepositoryInterface, itemCache ItemCacheInvalidator) *QuestionService {
+	return &QuestionService{questionsRepository: questionsRepository, itemCache: itemCache}
+}
+
+func (s *QuestionService) Ask(ctx context.Context, itemID, question string) (*domain.Question, error) {
+	created, err := s.questionsRepository.Create(ctx, itemID, question)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(created.ItemID)
+	return created, nil
+}
+
+func (s *QuestionService) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	answered, err := s.questionsRepository.Answer(ctx, questionID, answer)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(answered.ItemID)
+	return answered, nil
+}
+
+func (s *QuestionService) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	return s.questionsRepository.ListByItem(ctx, query)
+}
+
+// invalidate drops the cached item, whose detail embeds its questions.
+func (s *QuestionService) invalidate(itemID string) {
+	if s.itemCache != nil {
+		s.itemCache.Invalidate(itemID)
+	}
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/cache"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockQuestionsRepository struct {
+	mock.Mock
+}
+
+func (m *MockQuestionsRepository) Create(ctx context.Context, itemID, question string) (*domain.Question, error) {
+	args := m.Called(itemID, question)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionsRepository) Answer(ctx context.Context, questionID, answer string) (*domain.Question, error) {
+	args := m.Called(questionID, answer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Question), args.Error(1)
+}
+
+func (m *MockQuestionsRepository) ListByItem(ctx context.Context, query domain.QuestionListQuery) (*domain.QuestionPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QuestionPage), args.Error(1)
+}
+
+func TestQuestionService_Ask(t *testing.T) {
+	mockRepo := &MockQuestionsRepository{}
+	service := NewQuestionService(mockRepo, nil)
+
+	expected := &domain.Question{ID: "question-id", ItemID: "item-id", Question: "Is it available?"}
+	mockRepo.On("Create", "item-id", "Is it available?").Return(expected, nil)
+
+	result, err := service.Ask(context.Background(), "item-id", "Is it available?")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestQuestionService_Answer_Conflict(t *testing.T) {
+	mockRepo := &MockQuestionsRepository{}
+	service := NewQuestionService(mockRepo, nil)
+
+	mockRepo.On("Answer", "question-id", "Yes").Return(nil, domain.ErrConflict)
+
+	result, err := service.Answer(context.Background(), "question-id", "Yes")
+
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestQuestionService_ListByItem(t *testing.T) {
+	mockRepo := &MockQuestionsRepository{}
+	service := NewQuestionService(mockRepo, nil)
+
+	query := domain.QuestionListQuery{ItemID: "item-id", Status: domain.QuestionStatusUnanswered, Limit: 10}
+	expected := &domain.QuestionPage{Questions: []domain.Question{{ID: "question-id"}}}
+	mockRepo.On("ListByItem", query).Return(expected, nil)
+
+	result, err := service.ListByItem(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestQuestionService_AskAndAnswer_WithMemoryRepository(t *testing.T) {
+	service := NewQuestionService(repositories.NewMemoryQuestionsRepository(newSeededMemoryStore(t)), nil)
+
+	asked, err := service.Ask(context.Background(), seedItemID, "¿Tiene garantía?")
+	assert.NoError(t, err)
+
+	answered, err := service.Answer(context.Background(), asked.ID, "Sí")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sí", answered.Answer)
+
+	_, err = service.Answer(context.Background(), asked.ID, "Sí")
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	page, err := service.ListByItem(context.Background(), domain.QuestionListQuery{ItemID: seedItemID, Status: domain.QuestionStatusAnswered})
+	assert.NoError(t, err)
+	assert.Len(t, page.Questions, 2)
+}
+
+func TestQuestionService_InvalidatesCachedItem(t *testing.T) {
+	store := newSeededMemoryStore(t)
+	items := cache.NewItems(repositories.NewMemoryItemsRepository(store), 10, time.Hour, nil)
+	service := NewQuestionService(repositories.NewMemoryQuestionsRepository(store), items)
+	ctx := context.Background()
+
+	before, err := items.GetEnriched(ctx, seedItemID)
+	require.NoError(t, err)
+
+	asked, err := service.Ask(ctx, seedItemID, "¿Tiene garantía?")
+	require.NoError(t, err)
+	afterAsk, err := items.GetEnriched(ctx, seedItemID)
+	require.NoError(t, err)
+	require.Len(t, afterAsk.Questions, len(before.Questions)+1)
+	question, found := lo.Find(afterAsk.Questions, func(q domain.Question) bool { return q.ID == asked.ID })
+	require.True(t, found)
+	assert.Empty(t, question.Answer)
+
+	_, err = service.Answer(ctx, asked.ID, "Sí, 12 meses")
+	require.NoError(t, err)
+	afterAnswer, err := items.GetEnriched(ctx, seedItemID)
+	require.NoError(t, err)
+	question, found = lo.Find(afterAnswer.Questions, func(q domain.Question) bool { return q.ID == asked.ID })
+	require.True(t, found)
+	assert.Equal(t, "Sí, 12 meses", question.Answer)
+}
+
+func TestQuestionService_Ask_FailureKeepsCachedItem(t *testing.T) {
+	mockRepo := &MockQuestionsRepository{}
+	invalidator := &recordingInvalidator{}
+	service := NewQuestionService(mockRepo, invalidator)
+
+	mockRepo.On("Create", "missing", "Is it available?").Return(nil, domain.ErrNotFound)
+
+	_, err := service.Ask(context.Background(), "missing", "Is it available?")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Empty(t, invalidator.itemIDs)
+}
+
+// recordingInvalidator records the items it is asked to invalidate.
+type recordingInvalidator struct {
+	itemIDs []string
+}
+
+func (r *recordingInvalidator) Invalidate(itemID string) bool {
+	r.itemIDs = append(r.itemIDs, itemID)
+	return true
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type ReviewsRepositoryInterface interface {
+	Create(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error)
+	ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error)
+}
+
+type ReviewService struct {
+	reviewsRepository ReviewsRepositoryInterface
+	itemCache         ItemCacheInvalidator
+}
+
+// NewReviewService creates the service. itemCache may be nil when the item
+// cache is disabled.
+func NewReviewService(reviewsRepository ReviewsRepositoryInterface, itemCache ItemCacheInvalidator) *ReviewService {
+	return &ReviewService{reviewsRepository: reviewsRepository, itemCache: itemCache}
+}
+
+// Submit stores the review and drops every item of the product from the item
+// cache: the reviewed item embeds the reviews, and all of them the product
+// rating.
+func (s *ReviewService) Submit(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	submitted, err := s.reviewsRepository.Create(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	if s.itemCache != nil {
+		for _, itemID := range submitted.ProductItemIDs {
+			s.itemCache.Invalidate(itemID)
+		}
+	}
+	return submitted, nil
+}
+
+func (s *ReviewService) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	return s.reviewsRepository.ListByItem(ctx, query)
+}
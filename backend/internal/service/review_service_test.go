@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/cache"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockReviewsRepository struct {
+	mock.Mock
+}
+
+func (m *MockReviewsRepository) Create(ctx context.Context, review domain.NewReview) (*domain.SubmittedReview, error) {
+	args := m.Called(review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SubmittedReview), args.Error(1)
+}
+
+func (m *MockReviewsRepository) ListByItem(ctx context.Context, query domain.ReviewListQuery) (*domain.ReviewPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ReviewPage), args.Error(1)
+}
+
+func TestReviewService_Submit_Success(t *testing.T) {
+	mockRepo := &MockReviewsRepository{}
+	service := NewReviewService(mockRepo, nil)
+
+	review := domain.NewReview{ItemID: "item-id", Rating: 4, Content: "Good phone"}
+	expected := &domain.SubmittedReview{
+		Review:           domain.Review{ID: "review-id", Rating: 4, Content: "Good phone"},
+		AggregatedReview: domain.AggregatedReview{RatingValue: 4.5, RatingCount: 2},
+	}
+	mockRepo.On("Create", review).Return(expected, nil)
+
+	result, err := service.Submit(context.Background(), review)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReviewService_Submit_ItemNotFound(t *testing.T) {
+	mockRepo := &MockReviewsRepository{}
+	service := NewReviewService(mockRepo, nil)
+
+	review := domain.NewReview{ItemID: "missing", Rating: 4}
+	mockRepo.On("Create", review).Return(nil, domain.ErrNotFound)
+
+	result, err := service.Submit(context.Background(), review)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Nil(t, result)
+}
+
+func TestReviewService_Submit_InvalidatesEveryItemOfTheProduct(t *testing.T) {
+	mockRepo := &MockReviewsRepository{}
+	invalidator := &recordingInvalidator{}
+	service := NewReviewService(mockRepo, invalidator)
+
+	review := domain.NewReview{ItemID: "item-1", Rating: 4}
+	mockRepo.On("Create", review).Return(&domain.SubmittedReview{ProductItemIDs: []string{"item-1", "item-2", "item-3"}}, nil)
+
+	_, err := service.Submit(context.Background(), review)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"item-1", "item-2", "item-3"}, invalidator.itemIDs)
+}
+
+func TestReviewService_Submit_InvalidatesCachedItem(t *testing.T) {
+	store := newSeededMemoryStore(t)
+	items := cache.NewItems(repositories.NewMemoryItemsRepository(store), 10, time.Hour, nil)
+	service := NewReviewService(repositories.NewMemoryReviewsRepository(store), items)
+	ctx := context.Background()
+
+	_, err := items.GetEnriched(ctx, seedItemID)
+	require.NoError(t, err)
+
+	submitted, err := service.Submit(ctx, domain.NewReview{ItemID: seedItemID, Rating: 1, Content: "Dejó de andar"})
+	require.NoError(t, err)
+
+	after, err := items.GetEnriched(ctx, seedItemID)
+	require.NoError(t, err)
+	require.NotEmpty(t, after.Reviews)
+	assert.Equal(t, submitted.Review.ID, after.Reviews[0].ID)
+	assert.Equal(t, submitted.AggregatedReview.RatingValue, after.UserProduct.Product.AggregatedReview.RatingValue)
+	assert.Equal(t, submitted.AggregatedReview.RatingCount, after.UserProduct.Product.AggregatedReview.RatingCount)
+}
+
+func TestReviewService_ListByItem(t *testing.T) {
+	mockRepo := &MockReviewsRepository{}
+	service := NewReviewService(mockRepo, nil)
+
+	query := domain.ReviewListQuery{ItemID: "item-id", Scope: domain.ReviewScopeProduct, Sort: domain.ReviewSortLowest, Rating: 1}
+	expected := &domain.ReviewPage{Reviews: []domain.Review{{ID: "review-id", Rating: 1}}}
+	mockRepo.On("ListByItem", query).Return(expected, nil)
+
+	result, err := service.ListByItem(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	mockRepo.AssertExpectations(t)
+}
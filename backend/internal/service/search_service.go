@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type SearchRepositoryInterface interface {
+	Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error)
+}
+
+type SearchService struct {
+	searchRepository SearchRepositoryInterface
+}
+
+func NewSearchService(searchRepository SearchRepositoryInterface) *SearchService {
+	return &SearchService{searchRepository: searchRepository}
+}
+
+func (s *SearchService) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error) {
+	return s.searchRepository.Search(ctx, query)
+}
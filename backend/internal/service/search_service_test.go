@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"meli-backend/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSearchRepository struct {
+	mock.Mock
+}
+
+func (m *MockSearchRepository) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchPage, error) {
+	args := m.Called(query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SearchPage), args.Error(1)
+}
+
+func TestSearchService_Search_Success(t *testing.T) {
+	mockRepo := &MockSearchRepository{}
+	service := NewSearchService(mockRepo)
+
+	query := domain.SearchQuery{Text: "samsung 256gb", Limit: 10}
+	expectedPage := &domain.SearchPage{
+		Results: []domain.SearchResult{{Item: domain.ItemSummary{ID: "test-id"}, Rank: 0.5}},
+	}
+
+	mockRepo.On("Search", query).Return(expectedPage, nil)
+
+	result, err := service.Search(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPage, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchService_Search_Error(t *testing.T) {
+	mockRepo := &MockSearchRepository{}
+	service := NewSearchService(mockRepo)
+
+	query := domain.SearchQuery{Text: "samsung"}
+	expectedError := errors.New("database error")
+
+	mockRepo.On("Search", query).Return(nil, expectedError)
+
+	result, err := service.Search(context.Background(), query)
+
+	assert.Equal(t, expectedError, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
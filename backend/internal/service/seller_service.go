@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"meli-backend/internal/domain"
+)
+
+type SellersRepositoryInterface interface {
+	GetProfile(ctx context.Context, sellerID string) (*domain.SellerProfile, error)
+}
+
+type SellerItemsRepositoryInterface interface {
+	List(ctx context.Context, query domain.ItemListQuery) (*domain.ItemSummaryPage, error)
+}
+
+type SellerService struct {
+	sellersRepository SellersRepositoryInterface
+	itemsRepository   SellerItemsRepositoryInterface
+}
+
+func NewSellerService(sellersRepository SellersRepositoryInterface, itemsRepository SellerItemsRepositoryInterface) *SellerService {
+	return &SellerService{
+		sellersRepository: sellersRepository,
+		itemsRepository:   itemsRepository,
+	}
+}
+
+// GetProfile returns the seller profile along with a page of its active
+// items, newest first.
+func (s *SellerService) GetProfile(ctx context.Context, query domain.SellerProfileQuery) (*domain.SellerProfile, error) {
+	profile, err := s.sellersRepository.GetProfile(ctx, query.SellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.itemsRepository.List(ctx, domain.ItemListQuery{
+		Filter: domain.ItemFilter{
+			SellerID: query.SellerID,
+			InStock:  true,
+		},
+		Sort:   domain.ItemSortNewest,
+		Cursor: query.Cursor,
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	profile.Items = *items
+
+	return profile, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"meli-backend/internal/db/seeds"
+	"meli-backend/internal/domain"
+	"meli-backend/internal/repositories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSellersRepository struct {
+	mock.Mock
+}
+
+func (m *MockSellersRepository) GetProfile(ctx context.Context, sellerID string) (*domain.SellerProfile, error) {
+	args := m.Called(sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SellerProfile), args.Error(1)
+}
+
+// Identifiers of the seed data, see internal/db/seeds.
+const (
+	seedItemID   = "55747713-9cd4-45f7-a4cd-9916ed17a61d"
+	seedSellerID = "1e1e0031-a2dd-43ab-b8a6-12d62d0a1bec"
+)
+
+// newSeededMemoryStore returns an in-memory store holding the seed data, for
+// tests going through the repositories instead of mocking them.
+func newSeededMemoryStore(t *testing.T) *repositories.MemoryStore {
+	t.Helper()
+	store := repositories.NewMemoryStore()
+	require.NoError(t, store.LoadSeeds(seeds.FS))
+	return store
+}
+
+func TestNewSellerService(t *testing.T) {
+	mockSellers := &MockSellersRepository{}
+	mockItems := &MockItemsRepository{}
+	service := NewSellerService(mockSellers, mockItems)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockSellers, service.sellersRepository)
+	assert.Equal(t, mockItems, service.itemsRepository)
+}
+
+func TestSellerService_GetProfile_Success(t *testing.T) {
+	mockSellers := &MockSellersRepository{}
+	mockItems := &MockItemsRepository{}
+	service := NewSellerService(mockSellers, mockItems)
+
+	mockSellers.On("GetProfile", "seller-id").Return(&domain.SellerProfile{
+		Seller: domain.Seller{ID: "seller-id", Name: "Samsung"},
+		Stats:  domain.SellerStats{ProductsCount: 2},
+	}, nil)
+	mockItems.On("List", domain.ItemListQuery{
+		Filter: domain.ItemFilter{SellerID: "seller-id", InStock: true},
+		Sort:   domain.ItemSortNewest,
+		Cursor: "cursor",
+		Limit:  10,
+	}).Return(&domain.ItemSummaryPage{
+		Items:      []domain.ItemSummary{{ID: "item-1"}},
+		NextCursor: "next",
+	}, nil)
+
+	result, err := service.GetProfile(context.Background(), domain.SellerProfileQuery{SellerID: "seller-id", Cursor: "cursor", Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Samsung", result.Seller.Name)
+	assert.Equal(t, 2, result.Stats.ProductsCount)
+	assert.Len(t, result.Items.Items, 1)
+	assert.Equal(t, "next", result.Items.NextCursor)
+	mockSellers.AssertExpectations(t)
+	mockItems.AssertExpectations(t)
+}
+
+func TestSellerService_GetProfile_NotFound(t *testing.T) {
+	mockSellers := &MockSellersRepository{}
+	mockItems := &MockItemsRepository{}
+	service := NewSellerService(mockSellers, mockItems)
+
+	mockSellers.On("GetProfile", "missing").Return(nil, domain.ErrNotFound)
+
+	result, err := service.GetProfile(context.Background(), domain.SellerProfileQuery{SellerID: "missing"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	mockItems.AssertNotCalled(t, "List", mock.Anything)
+}
+
+func TestSellerService_GetProfile_ItemsError(t *testing.T) {
+	mockSellers := &MockSellersRepository{}
+	mockItems := &MockItemsRepository{}
+	service := NewSellerService(mockSellers, mockItems)
+
+	mockSellers.On("GetProfile", "seller-id").Return(&domain.SellerProfile{}, nil)
+	mockItems.On("List", mock.Anything).Return(nil, errors.New("database error"))
+
+	result, err := service.GetProfile(context.Background(), domain.SellerProfileQuery{SellerID: "seller-id"})
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "database error")
+}
+
+func TestSellerService_GetProfile_WithMemoryRepositories(t *testing.T) {
+	store := newSeededMemoryStore(t)
+	service := NewSellerService(repositories.NewMemorySellersRepository(store), repositories.NewMemoryItemsRepository(store))
+
+	profile, err := service.GetProfile(context.Background(), domain.SellerProfileQuery{SellerID: seedSellerID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Link Game", profile.Seller.Name)
+	assert.Equal(t, 1, profile.Stats.ActiveItemsCount)
+	assert.Len(t, profile.Items.Items, 1)
+	assert.Equal(t, seedItemID, profile.Items.Items[0].ID)
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetup_None(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Options{Exporter: ExporterNone})
+
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+}
+
+func TestNewExporter(t *testing.T) {
+	var out bytes.Buffer
+
+	exporter, err := newExporter(context.Background(), Options{Exporter: ExporterStdout}, &out)
+	require.NoError(t, err)
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	_, span := provider.Tracer(TracerName).Start(context.Background(), "GET /api/v1/items/:id")
+	span.End()
+	require.NoError(t, provider.Shutdown(context.Background()))
+	assert.Contains(t, out.String(), `"Name":"GET /api/v1/items/:id"`)
+
+	_, err = newExporter(context.Background(), Options{Exporter: ExporterOTLP, OTLPEndpoint: "localhost:4318"}, &out)
+	assert.NoError(t, err)
+
+	_, err = newExporter(context.Background(), Options{Exporter: "jaeger"}, &out)
+	assert.EqualError(t, err, `unknown trace exporter "jaeger"`)
+}
+
+func TestTraceID(t *testing.T) {
+	assert.Equal(t, "", TraceID(context.Background()))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID}))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", TraceID(ctx))
+}
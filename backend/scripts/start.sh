@@ -1,15 +1,10 @@
 #!/bin/sh
 
-# Wait for database to be ready
-echo "Waiting for database to be ready..."
-while ! nc -z $DB_HOST $DB_PORT; do
-  sleep 1
-done
-echo "Database is ready!"
-
-# Run migrations
+# Apply pending migrations; the binary waits for the database to accept
+# connections and holds an advisory lock, so replicas starting together
+# don't race
 echo "Running database migrations..."
-dbmate up
+./meli-backend migrate up
 if [ $? -ne 0 ]; then
   echo "Failed to run migrations"
   exit 1
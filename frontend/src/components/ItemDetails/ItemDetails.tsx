@@ -74,6 +74,8 @@ const ItemDetails: React.FC<ItemDetailsProps> = ({ itemId }) => {
         <Border>
           <ItemInfoCard
             {...result.generalInfo}
+            price={Number(result.generalInfo.price.amount)}
+            currency={result.generalInfo.price.currency}
           />
         </Border>
         <SellerCard
@@ -50,11 +50,18 @@ export interface Item {
   }
   
   
+  export interface Money {
+    amount: string;
+    currency: string;
+    symbol: string;
+  }
+
+
   export interface GeneralInfo {
     title: string;
     rating: number;
     reviewCount: number;
-    price: number;
+    price: Money;
     status: string;
     soldCount: number;
   }
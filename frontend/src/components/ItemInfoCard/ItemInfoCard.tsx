@@ -24,6 +24,7 @@ interface ItemInfoCardProps {
   rating: number;
   reviewCount: number;
   price: number;
+  currency?: string;
   status: string;
   soldCount: number;
 }
@@ -33,6 +34,7 @@ const ItemInfoCard: React.FC<ItemInfoCardProps> = ({
   rating, 
   reviewCount, 
   price,
+  currency = 'COP',
   status,
   soldCount
 }) => {
@@ -50,7 +52,7 @@ const ItemInfoCard: React.FC<ItemInfoCardProps> = ({
   const formatPrice = (price: number) => {
     return new Intl.NumberFormat('es-CO', {
       style: 'currency',
-      currency: 'COP',
+      currency,
       minimumFractionDigits: 0,
       maximumFractionDigits: 0
     }).format(price);